@@ -0,0 +1,149 @@
+package gatussdk
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultAnomalyWindow is the default number of previous results an anomaly is detected against.
+	DefaultAnomalyWindow = 30
+	// DefaultAnomalyThreshold is the default score above which a result is considered an anomaly.
+	DefaultAnomalyThreshold = 3.0
+	// DefaultAnomalyMinHistory is the default minimum number of previous results needed to detect an anomaly.
+	DefaultAnomalyMinHistory = 5
+	// DefaultAnomalyMinSpread is the default minimum spread assumed for the response times of previous results.
+	DefaultAnomalyMinSpread = time.Millisecond
+)
+
+// AnomalyMethod is the statistical method used to detect anomalies.
+type AnomalyMethod int
+
+const (
+	// AnomalyMedian scores results by their deviation from the rolling median, in units of the scaled median
+	// absolute deviation. It is robust to previous outliers, which makes it the default.
+	AnomalyMedian AnomalyMethod = iota
+	// AnomalyZScore scores results by their deviation from the rolling mean, in standard deviations.
+	AnomalyZScore
+)
+
+// madScale scales the median absolute deviation to be comparable to the standard deviation of normally
+// distributed values.
+const madScale = 1.4826
+
+// AnomalyOptions configures DetectAnomalies. Zero values use the defaults.
+type AnomalyOptions struct {
+	// Method is the statistical method used. Defaults to AnomalyMedian.
+	Method AnomalyMethod
+	// Window is the number of previous successful results each result is compared to.
+	// Defaults to DefaultAnomalyWindow.
+	Window int
+	// Threshold is the score above which a result is an anomaly. Defaults to DefaultAnomalyThreshold.
+	Threshold float64
+	// MinHistory is the minimum number of previous successful results needed to score a result.
+	// Defaults to DefaultAnomalyMinHistory.
+	MinHistory int
+	// MinSpread is the minimum spread assumed for the previous response times, so that tiny variations
+	// compared to a perfectly stable history are not flagged. Defaults to DefaultAnomalyMinSpread.
+	MinSpread time.Duration
+}
+
+func (o AnomalyOptions) withDefaults() AnomalyOptions {
+	if o.Window <= 0 {
+		o.Window = DefaultAnomalyWindow
+	}
+	if o.Threshold <= 0 {
+		o.Threshold = DefaultAnomalyThreshold
+	}
+	if o.MinHistory <= 0 {
+		o.MinHistory = DefaultAnomalyMinHistory
+	}
+	if o.MinSpread <= 0 {
+		o.MinSpread = DefaultAnomalyMinSpread
+	}
+	return o
+}
+
+// Anomaly is a result whose response time is a statistical outlier compared to the previous results.
+type Anomaly struct {
+	// Index is the index of the result in the results passed to DetectAnomalies.
+	Index int `json:"index"`
+	// Result is the anomalous result.
+	Result EndpointResult `json:"result"`
+	// Baseline is the typical response time of the previous results: their median or mean, depending on the method.
+	Baseline time.Duration `json:"baseline"`
+	// Score is the deviation of the response time from the baseline, in units of spread. It is positive when
+	// the response time is higher than the baseline, and negative when it is lower.
+	Score float64 `json:"score"`
+}
+
+// DetectAnomalies returns the results whose response time deviates from that of the previous results by more
+// than the threshold, in chronological order. Results are expected in chronological order, as returned by Gatus.
+// Only successful results are scored and used as history, since the response time of failed results usually
+// reflects a timeout rather than the latency of the endpoint.
+//
+// Example:
+//
+//	for _, anomaly := range DetectAnomalies(status.Results, AnomalyOptions{Threshold: 4}) {
+//	    if anomaly.Score > 0 {
+//	        fmt.Printf("%s: %s (usually %s)\n", anomaly.Result.Timestamp, anomaly.Result.Elapsed, anomaly.Baseline)
+//	    }
+//	}
+func DetectAnomalies(results []EndpointResult, options AnomalyOptions) []Anomaly {
+	options = options.withDefaults()
+	var anomalies []Anomaly
+	var history []float64
+	for i, result := range results {
+		if !result.Success {
+			continue
+		}
+		value := float64(result.Elapsed)
+		if len(history) >= options.MinHistory {
+			baseline, spread := baselineAndSpread(history, options.Method)
+			spread = math.Max(spread, float64(options.MinSpread))
+			if score := (value - baseline) / spread; math.Abs(score) > options.Threshold {
+				anomalies = append(anomalies, Anomaly{Index: i, Result: result, Baseline: time.Duration(baseline), Score: score})
+			}
+		}
+		history = append(history, value)
+		if len(history) > options.Window {
+			history = history[1:]
+		}
+	}
+	return anomalies
+}
+
+// baselineAndSpread returns the median and scaled median absolute deviation, or the mean and standard deviation,
+// of values.
+func baselineAndSpread(values []float64, method AnomalyMethod) (float64, float64) {
+	if method == AnomalyZScore {
+		var sum float64
+		for _, value := range values {
+			sum += value
+		}
+		mean := sum / float64(len(values))
+		var squares float64
+		for _, value := range values {
+			squares += (value - mean) * (value - mean)
+		}
+		return mean, math.Sqrt(squares / float64(len(values)))
+	}
+	med := median(values)
+	deviations := make([]float64, len(values))
+	for i, value := range values {
+		deviations[i] = math.Abs(value - med)
+	}
+	return med, median(deviations) * madScale
+}
+
+// median returns the median of values without modifying them.
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
@@ -0,0 +1,63 @@
+package gatussdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectAnomalies(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ms := time.Millisecond
+	results := newTimedResults(start,
+		100*ms, 104*ms, 98*ms, 101*ms, 99*ms, 103*ms, 97*ms,
+		900*ms, // Spike
+		102*ms, 100*ms,
+		5*time.Second, // Failed, ignored
+		101*ms,
+		20*ms, // Drop
+		99*ms,
+	)
+	results[10].Success = false
+	tests := []struct {
+		name            string
+		options         AnomalyOptions
+		expectedIndexes []int
+	}{
+		{name: "median", expectedIndexes: []int{7, 12}},
+		{name: "z-score", options: AnomalyOptions{Method: AnomalyZScore}, expectedIndexes: []int{7}},
+		{name: "min history", options: AnomalyOptions{MinHistory: 10}, expectedIndexes: []int{12}},
+		{name: "high threshold", options: AnomalyOptions{Threshold: 1000}, expectedIndexes: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anomalies := DetectAnomalies(results, tt.options)
+			if len(anomalies) != len(tt.expectedIndexes) {
+				t.Fatalf("got %d anomalies, want %d: %+v", len(anomalies), len(tt.expectedIndexes), anomalies)
+			}
+			for i, anomaly := range anomalies {
+				if anomaly.Index != tt.expectedIndexes[i] || !anomaly.Result.Timestamp.Equal(results[anomaly.Index].Timestamp) {
+					t.Errorf("anomalies[%d].Index = %d, want %d", i, anomaly.Index, tt.expectedIndexes[i])
+				}
+			}
+		})
+	}
+
+	anomalies := DetectAnomalies(results, AnomalyOptions{})
+	if anomalies[0].Score <= 0 || anomalies[1].Score >= 0 {
+		t.Errorf("expected a positive then a negative score, got %v and %v", anomalies[0].Score, anomalies[1].Score)
+	}
+	if anomalies[0].Baseline != 100*ms {
+		t.Errorf("Baseline = %v, want 100ms", anomalies[0].Baseline)
+	}
+}
+
+func TestDetectAnomalies_StableHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ms := time.Millisecond
+	// A perfectly stable history has no spread, so the minimum spread prevents flagging tiny variations
+	results := newTimedResults(start, 100*ms, 100*ms, 100*ms, 100*ms, 100*ms, 102*ms, 150*ms)
+	anomalies := DetectAnomalies(results, AnomalyOptions{})
+	if len(anomalies) != 1 || anomalies[0].Index != 6 {
+		t.Errorf("unexpected anomalies: %+v", anomalies)
+	}
+}
@@ -0,0 +1,51 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+)
+
+// AssertAllHealthy retrieves the status of every endpoint and returns an error listing every endpoint whose most
+// recent result failed, along with its errors, or nil if none did. It is designed to be the body of a CI job that
+// fails the build when monitoring is red.
+//
+// The returned error joins an *UnhealthyEndpointError per failing endpoint, in the order Gatus returned them,
+// so that errors.Is(err, ErrUnhealthy) tells them apart from errors retrieving the statuses.
+//
+// Example:
+//
+//	if err := client.AssertAllHealthy(context.Background()); err != nil {
+//	    log.Fatal(err) // One line per failing endpoint
+//	}
+func (c *Client) AssertAllHealthy(ctx context.Context, opts ...RequestOption) error {
+	statuses, err := c.GetUnhealthyEndpoints(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	return unhealthyError(statuses)
+}
+
+// AssertGroupHealthy is like AssertAllHealthy, but only for the endpoints of group.
+// Use an empty group for endpoints without a group.
+//
+// Example:
+//
+//	if err := client.AssertGroupHealthy(context.Background(), "core"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) AssertGroupHealthy(ctx context.Context, group string, opts ...RequestOption) error {
+	statuses, err := c.GetUnhealthyEndpointsInGroup(ctx, group, opts...)
+	if err != nil {
+		return err
+	}
+	return unhealthyError(statuses)
+}
+
+// unhealthyError joins an *UnhealthyEndpointError for each of statuses, which must have a failed most recent result.
+func unhealthyError(statuses []EndpointStatus) error {
+	errs := make([]error, len(statuses))
+	for i := range statuses {
+		errs[i] = &UnhealthyEndpointError{Key: statuses[i].Key, Result: *statuses[i].LastResult()}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,77 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_AssertAllHealthy(t *testing.T) {
+	statuses := []EndpointStatus{
+		{Key: "core_api", Group: "core", Results: []EndpointResult{{Success: false}, {Success: false, Errors: []string{"timeout"}}}},
+		{Key: "core_db", Group: "core", Results: []EndpointResult{{Success: false}, {Success: true}}},
+		{Key: "edge_cdn", Group: "edge", Results: []EndpointResult{{Success: false, ConditionResults: []ConditionResult{{Condition: "[STATUS] (503) == 200"}}}}},
+		{Key: "core_new", Group: "core"},
+	}
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(statuses)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	tests := []struct {
+		name     string
+		assert   func() error
+		expected string
+	}{
+		{
+			name:     "all groups",
+			assert:   func() error { return client.AssertAllHealthy(context.Background()) },
+			expected: "endpoint core_api is unhealthy: timeout\nendpoint edge_cdn is unhealthy: condition [STATUS] (503) == 200 failed",
+		},
+		{
+			name:     "group",
+			assert:   func() error { return client.AssertGroupHealthy(context.Background(), "edge") },
+			expected: "endpoint edge_cdn is unhealthy: condition [STATUS] (503) == 200 failed",
+		},
+		{
+			name:   "healthy group",
+			assert: func() error { return client.AssertGroupHealthy(context.Background(), "missing") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.assert()
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expected {
+				t.Fatalf("got %v, want %q", err, tt.expected)
+			}
+			if !errors.Is(err, ErrUnhealthy) {
+				t.Error("expected error to match ErrUnhealthy")
+			}
+			var unhealthyErr *UnhealthyEndpointError
+			if !errors.As(err, &unhealthyErr) {
+				t.Error("expected an *UnhealthyEndpointError")
+			}
+		})
+	}
+
+	failing = true
+	err := client.AssertAllHealthy(context.Background())
+	if err == nil || errors.Is(err, ErrUnhealthy) {
+		t.Errorf("expected a request error, got %v", err)
+	}
+}
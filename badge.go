@@ -0,0 +1,244 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBadgeCacheTTL is the default duration for which BadgeHandler caches badges.
+const DefaultBadgeCacheTTL = time.Minute
+
+// maxCachedBadges is the maximum number of badges cached by a BadgeHandler. Since the cache is keyed by the
+// options of requests, it must be bounded so that requests with random labels cannot grow it without limit.
+const maxCachedBadges = 1000
+
+// BadgeOptions customizes the appearance of a badge, e.g. to match the branding of the page embedding it.
+// Empty fields are omitted, leaving the defaults of Gatus in place. Instances that do not support a customization
+// ignore it.
+type BadgeOptions struct {
+	// Label replaces the text on the left side of the badge.
+	Label string
+	// Style is the style of the badge (e.g. "flat", "flat-square", "plastic", "for-the-badge").
+	Style string
+	// Color is the background color of the right side of the badge, as a color name or hexadecimal code without "#".
+	Color string
+	// LabelColor is the background color of the left side of the badge.
+	LabelColor string
+}
+
+// badgeQueryParameters are the names of the query parameters set by BadgeOptions.
+var badgeQueryParameters = []string{"label", "style", "color", "labelColor"}
+
+// query returns the query parameters corresponding to the options.
+func (o BadgeOptions) query() url.Values {
+	query := make(url.Values)
+	for i, value := range []string{o.Label, o.Style, o.Color, o.LabelColor} {
+		if value != "" {
+			query.Set(badgeQueryParameters[i], value)
+		}
+	}
+	return query
+}
+
+// badgeQuery returns the query string, including the leading "?", corresponding to the last of the given options,
+// or an empty string if there is none.
+func badgeQuery(options []BadgeOptions) string {
+	if len(options) == 0 {
+		return ""
+	}
+	query := options[len(options)-1].query()
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
+
+// GetBadge retrieves the SVG of a badge whose URL was returned by one of the badge URL builders of the client,
+// such as GetEndpointHealthBadgeURL.
+//
+// Example:
+//
+//	svg, err := client.GetBadge(context.Background(), client.GetEndpointHealthBadgeURL("core_blog-home"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	os.WriteFile("health.svg", svg, 0o644)
+func (c *Client) GetBadge(ctx context.Context, badgeURL string, opts ...RequestOption) ([]byte, error) {
+	path, ok := strings.CutPrefix(badgeURL, c.baseURL)
+	if !ok || !strings.HasPrefix(path, "/") {
+		return nil, &ValidationError{
+			Field:   "badgeURL",
+			Message: "must be a URL of the client's Gatus instance",
+		}
+	}
+	resp, err := c.doRequest(ctx, http.MethodGet, path, append(opts[:len(opts):len(opts)], WithHeader("Accept", "image/svg+xml"))...)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, c.decodeResponse(resp, nil)
+	}
+	defer resp.Body.Close()
+	reader, err := c.decompress(resp.Body, resp.Header.Get("Content-Encoding"))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	var content io.Reader = reader
+	if c.maxResponseSize > 0 {
+		content = &maxSizeReader{reader: reader, limit: c.maxResponseSize}
+	}
+	svg, err := io.ReadAll(content)
+	if err != nil {
+		return nil, fmt.Errorf("reading badge: %w", err)
+	}
+	return svg, nil
+}
+
+// BadgeHandler is an http.Handler serving the badges of a Gatus instance, fetched through a client and cached,
+// so that applications can expose badges to browsers without exposing the URL or the credentials of Gatus.
+//
+// It serves the following paths, mirroring those of the Gatus API:
+//
+//	/endpoints/{key}/health/badge.svg
+//	/endpoints/{key}/uptimes/{duration}/badge.svg
+//	/endpoints/{key}/response-times/{duration}/badge.svg
+//	/suites/{key}/health/badge.svg
+//
+// The label, style, color and labelColor query parameters of requests are forwarded (see BadgeOptions).
+// Requests for invalid keys or durations are rejected without reaching Gatus. At most 1000 badges are cached: when
+// the cache is full, expired badges are evicted first, then the badges expiring soonest.
+type BadgeHandler struct {
+	client *Client
+	ttl    time.Duration
+	mux    *http.ServeMux
+
+	mu    sync.Mutex
+	cache map[string]cachedBadge
+}
+
+// cachedBadge is a badge cached by BadgeHandler.
+type cachedBadge struct {
+	svg     []byte
+	expires time.Time
+}
+
+// NewBadgeHandler creates a BadgeHandler fetching badges with client and caching them for ttl.
+// If ttl is zero, DefaultBadgeCacheTTL is used. If ttl is negative, badges are not cached.
+//
+// Example:
+//
+//	http.Handle("/badges/", http.StripPrefix("/badges", NewBadgeHandler(client, 5*time.Minute)))
+//	// <img src="/badges/endpoints/core_blog-home/health/badge.svg">
+func NewBadgeHandler(client *Client, ttl time.Duration) *BadgeHandler {
+	if ttl == 0 {
+		ttl = DefaultBadgeCacheTTL
+	}
+	h := &BadgeHandler{client: client, ttl: ttl, mux: http.NewServeMux(), cache: make(map[string]cachedBadge)}
+	h.mux.HandleFunc("GET /endpoints/{key}/health/badge.svg", func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, r.PathValue("key"), "", func(key string, options BadgeOptions) string {
+			return client.GetEndpointHealthBadgeURL(key, options)
+		})
+	})
+	h.mux.HandleFunc("GET /endpoints/{key}/uptimes/{duration}/badge.svg", func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, r.PathValue("key"), r.PathValue("duration"), func(key string, options BadgeOptions) string {
+			return client.GetEndpointUptimeBadgeURL(key, r.PathValue("duration"), options)
+		})
+	})
+	h.mux.HandleFunc("GET /endpoints/{key}/response-times/{duration}/badge.svg", func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, r.PathValue("key"), r.PathValue("duration"), func(key string, options BadgeOptions) string {
+			return client.GetEndpointResponseTimeBadgeURL(key, r.PathValue("duration"), options)
+		})
+	})
+	h.mux.HandleFunc("GET /suites/{key}/health/badge.svg", func(w http.ResponseWriter, r *http.Request) {
+		h.serve(w, r, r.PathValue("key"), "", func(key string, options BadgeOptions) string {
+			return client.GetSuiteHealthBadgeURL(key, options)
+		})
+	})
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *BadgeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// serve validates the key and duration, if any, and writes the badge whose URL is returned by badgeURL.
+func (h *BadgeHandler) serve(w http.ResponseWriter, r *http.Request, key, duration string, badgeURL func(key string, options BadgeOptions) string) {
+	if ValidateKey(key) != nil || (duration != "" && !Duration(duration).IsValid()) {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	query := r.URL.Query()
+	options := BadgeOptions{
+		Label:      query.Get("label"),
+		Style:      query.Get("style"),
+		Color:      query.Get("color"),
+		LabelColor: query.Get("labelColor"),
+	}
+	svg, err := h.badge(r.Context(), badgeURL(key, options))
+	if err != nil {
+		statusCode := http.StatusBadGateway
+		if errors.Is(err, ErrNotFound) {
+			statusCode = http.StatusNotFound
+		}
+		// The error is not written to the response, as it may contain the URL of Gatus
+		http.Error(w, http.StatusText(statusCode), statusCode)
+		return
+	}
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if h.ttl > 0 {
+		w.Header().Set("Cache-Control", "max-age="+strconv.Itoa(int(h.ttl.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	_, _ = w.Write(svg)
+}
+
+// badge returns the badge at badgeURL, from the cache if it hasn't expired.
+func (h *BadgeHandler) badge(ctx context.Context, badgeURL string) ([]byte, error) {
+	now := h.client.clock.Now()
+	h.mu.Lock()
+	cached, ok := h.cache[badgeURL]
+	h.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.svg, nil
+	}
+	svg, err := h.client.GetBadge(ctx, badgeURL)
+	if err != nil {
+		return nil, err
+	}
+	if h.ttl > 0 {
+		h.mu.Lock()
+		if _, ok := h.cache[badgeURL]; !ok && len(h.cache) >= maxCachedBadges {
+			h.evict(now)
+		}
+		h.cache[badgeURL] = cachedBadge{svg: svg, expires: now.Add(h.ttl)}
+		h.mu.Unlock()
+	}
+	return svg, nil
+}
+
+// evict removes the expired badges from the cache, or the badge expiring soonest if none has expired.
+// It must be called with h.mu held.
+func (h *BadgeHandler) evict(now time.Time) {
+	var soonest string
+	for badgeURL, cached := range h.cache {
+		if !now.Before(cached.expires) {
+			delete(h.cache, badgeURL)
+		} else if soonest == "" || cached.expires.Before(h.cache[soonest].expires) {
+			soonest = badgeURL
+		}
+	}
+	if len(h.cache) >= maxCachedBadges {
+		delete(h.cache, soonest)
+	}
+}
@@ -0,0 +1,211 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testBadge = `<svg xmlns="http://www.w3.org/2000/svg"><text>up</text></svg>`
+
+func TestClient_GetBadge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "image/svg+xml" {
+			t.Errorf("Accept = %v, want image/svg+xml", r.Header.Get("Accept"))
+		}
+		if r.URL.Path != "/api/v1/endpoints/core_api/health/badge.svg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.Write([]byte(testBadge))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	svg, err := client.GetBadge(context.Background(), client.GetEndpointHealthBadgeURL("core_api"))
+	if err != nil {
+		t.Fatalf("GetBadge() error = %v", err)
+	}
+	if string(svg) != testBadge {
+		t.Errorf("GetBadge() = %s, want %s", svg, testBadge)
+	}
+	if _, err := client.GetBadge(context.Background(), client.GetEndpointHealthBadgeURL("core_missing")); err == nil {
+		t.Error("expected error for missing badge")
+	}
+	var validationErr *ValidationError
+	if _, err := client.GetBadge(context.Background(), "https://elsewhere.example.org/badge.svg"); !errors.As(err, &validationErr) {
+		t.Errorf("expected *ValidationError for foreign URL, got %v", err)
+	}
+}
+
+func TestBadgeHandler(t *testing.T) {
+	requests := 0
+	gatus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/api/v1/endpoints/core_api/health/badge.svg",
+			"/api/v1/endpoints/core_api/uptimes/7d/badge.svg",
+			"/api/v1/endpoints/core_api/response-times/24h/badge.svg",
+			"/api/v1/suites/_login/health/badge.svg":
+			w.Write([]byte(testBadge))
+		case "/api/v1/endpoints/core_broken/health/badge.svg":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer gatus.Close()
+
+	server := httptest.NewServer(http.StripPrefix("/badges", NewBadgeHandler(NewClient(gatus.URL, WithBearerToken("secret")), 0)))
+	defer server.Close()
+	tests := []struct {
+		path               string
+		expectedStatusCode int
+	}{
+		{path: "/badges/endpoints/core_api/health/badge.svg", expectedStatusCode: http.StatusOK},
+		{path: "/badges/endpoints/core_api/uptimes/7d/badge.svg", expectedStatusCode: http.StatusOK},
+		{path: "/badges/endpoints/core_api/response-times/24h/badge.svg", expectedStatusCode: http.StatusOK},
+		{path: "/badges/suites/_login/health/badge.svg", expectedStatusCode: http.StatusOK},
+		{path: "/badges/endpoints/core_missing/health/badge.svg", expectedStatusCode: http.StatusNotFound},
+		{path: "/badges/endpoints/core_broken/health/badge.svg", expectedStatusCode: http.StatusBadGateway},
+		{path: "/badges/endpoints/core_api/uptimes/2d/badge.svg", expectedStatusCode: http.StatusBadRequest},
+		{path: "/badges/endpoints/invalid/health/badge.svg", expectedStatusCode: http.StatusBadRequest},
+		{path: "/badges/endpoints/statuses", expectedStatusCode: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp, err := http.Get(server.URL + tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != tt.expectedStatusCode {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.expectedStatusCode)
+			}
+			if resp.StatusCode == http.StatusOK && (string(body) != testBadge || resp.Header.Get("Content-Type") != "image/svg+xml") {
+				t.Errorf("unexpected badge %s (%s)", body, resp.Header.Get("Content-Type"))
+			}
+			if strings.Contains(string(body), gatus.URL) || strings.Contains(string(body), "secret") {
+				t.Errorf("response leaks Gatus details: %s", body)
+			}
+		})
+	}
+
+	// Badges are cached
+	before := requests
+	resp, err := http.Get(server.URL + "/badges/endpoints/core_api/health/badge.svg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if requests != before {
+		t.Errorf("expected badge to be served from the cache, got %d requests", requests-before)
+	}
+}
+
+func TestBadgeOptions(t *testing.T) {
+	client := NewClient("https://status.example.com")
+	tests := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{
+			name:     "no options",
+			actual:   client.GetEndpointHealthBadgeURL("core_api"),
+			expected: "https://status.example.com/api/v1/endpoints/core_api/health/badge.svg",
+		},
+		{
+			name:     "empty options",
+			actual:   client.GetEndpointHealthBadgeURL("core_api", BadgeOptions{}),
+			expected: "https://status.example.com/api/v1/endpoints/core_api/health/badge.svg",
+		},
+		{
+			name:     "label and style",
+			actual:   client.GetEndpointUptimeBadgeURL("core_api", "24h", BadgeOptions{Label: "API uptime", Style: "flat-square"}),
+			expected: "https://status.example.com/api/v1/endpoints/core_api/uptimes/24h/badge.svg?label=API+uptime&style=flat-square",
+		},
+		{
+			name:     "colors",
+			actual:   client.GetEndpointResponseTimeBadgeURL("core_api", "7d", BadgeOptions{Color: "ff69b4", LabelColor: "#333"}),
+			expected: "https://status.example.com/api/v1/endpoints/core_api/response-times/7d/badge.svg?color=ff69b4&labelColor=%23333",
+		},
+		{
+			name:     "suite",
+			actual:   client.GetSuiteHealthBadgeURL("_login", BadgeOptions{Label: "login"}),
+			expected: "https://status.example.com/api/v1/suites/_login/health/badge.svg?label=login",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.actual != tt.expected {
+				t.Errorf("got %v, want %v", tt.actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBadgeHandler_ForwardsOptions(t *testing.T) {
+	var queries []string
+	gatus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Write([]byte(testBadge))
+	}))
+	defer gatus.Close()
+
+	server := httptest.NewServer(NewBadgeHandler(NewClient(gatus.URL), 0))
+	defer server.Close()
+	for _, query := range []string{"?style=flat&label=API&unknown=1", "?style=plastic", "?style=flat&label=API"} {
+		resp, err := http.Get(server.URL + "/endpoints/core_api/health/badge.svg" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	// The third request only differs in the unknown parameter, so it is served from the cache
+	expected := []string{"label=API&style=flat", "style=plastic"}
+	if strings.Join(queries, " ") != strings.Join(expected, " ") {
+		t.Errorf("queries = %v, want %v", queries, expected)
+	}
+}
+
+func TestBadgeHandler_BoundedCache(t *testing.T) {
+	gatus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testBadge))
+	}))
+	defer gatus.Close()
+
+	clock := &recordingClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	handler := NewBadgeHandler(NewClient(gatus.URL, WithClock(clock)), time.Minute)
+	serve := func(label string) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/endpoints/core_api/health/badge.svg?label="+label, nil))
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, recorder.Code)
+		}
+	}
+	for i := range maxCachedBadges + 10 {
+		clock.now = clock.now.Add(time.Millisecond)
+		serve(fmt.Sprint(i))
+	}
+	if len(handler.cache) != maxCachedBadges {
+		t.Errorf("expected %d cached badges, got %d", maxCachedBadges, len(handler.cache))
+	}
+	if _, ok := handler.cache[handler.client.GetEndpointHealthBadgeURL("core_api", BadgeOptions{Label: "0"})]; ok {
+		t.Error("expected the badge expiring soonest to be evicted")
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	serve("new")
+	if len(handler.cache) != 1 {
+		t.Errorf("expected expired badges to be evicted, got %d cached badges", len(handler.cache))
+	}
+}
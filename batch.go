@@ -0,0 +1,143 @@
+package gatussdk
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the default maximum number of concurrent requests made by batch methods.
+const DefaultBatchConcurrency = 10
+
+// GetEndpointStatusesByKeys retrieves the status of multiple endpoints concurrently,
+// with at most concurrency requests in flight at once.
+// If concurrency is zero or negative, the client's batch concurrency is used (see WithBatchConcurrency).
+//
+// The returned map contains the status of every endpoint that was retrieved successfully.
+// If any endpoint could not be retrieved, a *BatchError containing the error for each failed key is returned
+// alongside the partial results.
+//
+// Example:
+//
+//	statuses, err := client.GetEndpointStatusesByKeys(context.Background(), []string{"core_blog-home", "core_api"}, 5)
+//	var batchErr *BatchError
+//	if errors.As(err, &batchErr) {
+//	    for key, keyErr := range batchErr.Errors {
+//	        log.Printf("failed to get %s: %v", key, keyErr)
+//	    }
+//	}
+//	for key, status := range statuses {
+//	    fmt.Printf("%s has %d results\n", key, len(status.Results))
+//	}
+func (c *Client) GetEndpointStatusesByKeys(ctx context.Context, keys []string, concurrency int, opts ...RequestOption) (map[string]*EndpointStatus, error) {
+	if concurrency <= 0 {
+		concurrency = c.batchConcurrency
+	}
+	statuses := make(map[string]*EndpointStatus, len(keys))
+	var mu sync.Mutex
+	err := fanOut(ctx, keys, concurrency, func(ctx context.Context, key string) error {
+		status, err := c.GetEndpointStatusByKey(ctx, key, opts...)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		statuses[key] = status
+		mu.Unlock()
+		return nil
+	})
+	return statuses, err
+}
+
+// GetUptimes retrieves the uptime percentage of multiple endpoints concurrently.
+// Duration must be one of: 1h, 24h, 7d, 30d.
+// The number of requests in flight at once is limited by the client's batch concurrency (see WithBatchConcurrency).
+//
+// The returned map contains the uptime of every endpoint that was retrieved successfully.
+// If any uptime could not be retrieved, a *BatchError containing the error for each failed key is returned
+// alongside the partial results.
+//
+// Example:
+//
+//	uptimes, err := client.GetUptimes(context.Background(), []string{"core_blog-home", "core_api"}, "24h")
+//	if err != nil {
+//	    log.Printf("some uptimes could not be retrieved: %v", err)
+//	}
+//	for key, uptime := range uptimes {
+//	    fmt.Printf("%s: %.2f%%\n", key, uptime)
+//	}
+func (c *Client) GetUptimes(ctx context.Context, keys []string, duration string, opts ...RequestOption) (map[string]float64, error) {
+	uptimes := make(map[string]float64, len(keys))
+	var mu sync.Mutex
+	err := fanOut(ctx, keys, c.batchConcurrency, func(ctx context.Context, key string) error {
+		uptime, err := c.GetEndpointUptime(ctx, key, duration, opts...)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		uptimes[key] = uptime
+		mu.Unlock()
+		return nil
+	})
+	return uptimes, err
+}
+
+// fanOut calls fn for each unique key with at most concurrency calls in flight at once,
+// and returns a *BatchError containing the error of each failed call, if any.
+// If concurrency is zero or negative, DefaultBatchConcurrency is used.
+func fanOut(ctx context.Context, keys []string, concurrency int, fn func(ctx context.Context, key string) error) error {
+	unique := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+	failed := fanOutIndexes(ctx, len(unique), concurrency, func(ctx context.Context, i int) error {
+		return fn(ctx, unique[i])
+	})
+	if len(failed) == 0 {
+		return nil
+	}
+	errs := make(map[string]error, len(failed))
+	for i, err := range failed {
+		errs[unique[i]] = err
+	}
+	return &BatchError{Errors: errs}
+}
+
+// fanOutIndexes calls fn for each index from 0 to n-1 with at most concurrency calls in flight at once,
+// and returns the error of each failed call by index. If concurrency is zero or negative,
+// DefaultBatchConcurrency is used.
+func fanOutIndexes(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) error) map[int]error {
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		errs      = make(map[int]error)
+		semaphore = make(chan struct{}, concurrency)
+	)
+	for i := range n {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			errs[i] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			if err := fn(ctx, i); err != nil {
+				mu.Lock()
+				errs[i] = err
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,139 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_GetEndpointStatusesByKeys(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		key := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/endpoints/"), "/statuses")
+		if key == "core_missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(EndpointStatus{Key: key})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	keys := []string{"core_a", "core_b", "core_c", "core_d", "core_e", "core_missing", "core_a"}
+	statuses, err := client.GetEndpointStatusesByKeys(context.Background(), keys, 2)
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected BatchError, got %v", err)
+	}
+	if len(batchErr.Errors) != 1 || batchErr.Errors["core_missing"] == nil {
+		t.Errorf("unexpected errors: %v", batchErr.Errors)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected wrapped 404 APIError, got %v", err)
+	}
+	if len(statuses) != 5 {
+		t.Errorf("got %d statuses, want 5", len(statuses))
+	}
+	if statuses["core_c"] == nil || statuses["core_c"].Key != "core_c" {
+		t.Errorf("unexpected status for core_c: %+v", statuses["core_c"])
+	}
+	if max := atomic.LoadInt32(&maxInFlight); max > 2 {
+		t.Errorf("max in-flight requests = %d, want at most 2", max)
+	}
+}
+
+func TestClient_GetEndpointStatusesByKeys_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(EndpointStatus{Name: "api"})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	statuses, err := client.GetEndpointStatusesByKeys(context.Background(), []string{"core_a", "core_b"}, 0)
+	if err != nil {
+		t.Fatalf("GetEndpointStatusesByKeys() error = %v", err)
+	}
+	if len(statuses) != 2 {
+		t.Errorf("got %d statuses, want 2", len(statuses))
+	}
+}
+
+func TestBatchError_Error(t *testing.T) {
+	err := &BatchError{Errors: map[string]error{
+		"core_b": errors.New("boom"),
+		"core_a": &ValidationError{Field: "key", Message: "cannot be empty"},
+	}}
+	expected := "batch error: 2 failed: core_a: validation error: field 'key': cannot be empty; core_b: boom"
+	if err.Error() != expected {
+		t.Errorf("Error() = %v, want %v", err.Error(), expected)
+	}
+}
+
+func TestClient_GetUptimes(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if !strings.HasSuffix(r.URL.Path, "/uptimes/24h") {
+			t.Errorf("unexpected path: %v", r.URL.Path)
+		}
+		switch {
+		case strings.Contains(r.URL.Path, "core_a"):
+			json.NewEncoder(w).Encode(UptimeData{Uptime: 99.5, Duration: "24h"})
+		case strings.Contains(r.URL.Path, "core_b"):
+			json.NewEncoder(w).Encode(UptimeData{Uptime: 100, Duration: "24h"})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithBatchConcurrency(1))
+	uptimes, err := client.GetUptimes(context.Background(), []string{"core_a", "core_b", "core_x"}, "24h")
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || len(batchErr.Errors) != 1 || batchErr.Errors["core_x"] == nil {
+		t.Errorf("expected BatchError for core_x, got %v", err)
+	}
+	if len(uptimes) != 2 || uptimes["core_a"] != 99.5 || uptimes["core_b"] != 100 {
+		t.Errorf("unexpected uptimes: %v", uptimes)
+	}
+	if max := atomic.LoadInt32(&maxInFlight); max != 1 {
+		t.Errorf("max in-flight requests = %d, want 1", max)
+	}
+}
+
+func TestWithBatchConcurrency(t *testing.T) {
+	if client := NewClient("https://example.com"); client.batchConcurrency != DefaultBatchConcurrency {
+		t.Errorf("batchConcurrency = %d, want %d", client.batchConcurrency, DefaultBatchConcurrency)
+	}
+	if client := NewClient("https://example.com", WithBatchConcurrency(3)); client.batchConcurrency != 3 {
+		t.Errorf("batchConcurrency = %d, want 3", client.batchConcurrency)
+	}
+	if client := NewClient("https://example.com", WithBatchConcurrency(0)); client.batchConcurrency != DefaultBatchConcurrency {
+		t.Errorf("batchConcurrency = %d, want %d", client.batchConcurrency, DefaultBatchConcurrency)
+	}
+}
@@ -0,0 +1,111 @@
+package gatussdk
+
+import (
+	"fmt"
+	"time"
+)
+
+// BurnRateWindow is a pair of windows over which the burn rate of an error budget is evaluated, as in the
+// multi-window, multi-burn-rate alerts of the Google SRE workbook. An alert fires when the burn rate exceeds the
+// threshold over both windows: the long window ensures enough of the budget was consumed to matter, and the short
+// window ensures the budget is still being consumed, so that the alert resets quickly after recovery.
+type BurnRateWindow struct {
+	// Name identifies the alert (e.g. "fast" or "slow").
+	Name string `json:"name"`
+	// Long is the long window.
+	Long time.Duration `json:"long"`
+	// Short is the short window, typically a twelfth of the long window.
+	Short time.Duration `json:"short"`
+	// Threshold is the burn rate above which the alert fires.
+	Threshold float64 `json:"threshold"`
+}
+
+var (
+	// FastBurnWindow fires when 2% of a 30-day error budget is consumed within an hour.
+	FastBurnWindow = BurnRateWindow{Name: "fast", Long: time.Hour, Short: 5 * time.Minute, Threshold: 14.4}
+	// SlowBurnWindow fires when 5% of a 30-day error budget is consumed within 6 hours.
+	SlowBurnWindow = BurnRateWindow{Name: "slow", Long: 6 * time.Hour, Short: 30 * time.Minute, Threshold: 6}
+)
+
+// BurnRateAlert is the evaluation of a BurnRateWindow.
+type BurnRateAlert struct {
+	// Window is the evaluated window.
+	Window BurnRateWindow `json:"window"`
+	// LongBurnRate is the burn rate over the long window.
+	LongBurnRate float64 `json:"longBurnRate"`
+	// ShortBurnRate is the burn rate over the short window.
+	ShortBurnRate float64 `json:"shortBurnRate"`
+	// Firing indicates whether both burn rates exceed the threshold of the window.
+	Firing bool `json:"firing"`
+}
+
+// BurnRate returns the rate at which the error budget of an objective is consumed given the uptime, both as
+// percentages. A burn rate of 1 consumes exactly the error budget over the period of the objective.
+//
+// Example:
+//
+//	uptime, err := client.GetEndpointUptime(ctx, "core_api", "24h")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("burning the error budget %.1fx too fast\n", BurnRate(uptime, 99.9))
+func BurnRate(uptime, objective float64) float64 {
+	return (100 - uptime) / (100 - objective)
+}
+
+// EvaluateBurnRates evaluates the burn rate of the error budget of objective, a percentage such as 99.9, over each
+// window, computing the uptime of each window from the results within it as of now. Windows without results have
+// a burn rate of 0. Without windows, FastBurnWindow and SlowBurnWindow are evaluated.
+//
+// Since Gatus only retains a limited number of results per endpoint, the results must cover the long windows for
+// their burn rates to be accurate; retrieve them with GetAllEndpointStatusesAllPages if needed.
+//
+// Example:
+//
+//	alerts, err := EvaluateBurnRates(status.Results, 99.9, time.Now())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, alert := range alerts {
+//	    if alert.Firing {
+//	        fmt.Printf("%s burn: %.1fx over %s\n", alert.Window.Name, alert.LongBurnRate, alert.Window.Long)
+//	    }
+//	}
+func EvaluateBurnRates(results []EndpointResult, objective float64, now time.Time, windows ...BurnRateWindow) ([]BurnRateAlert, error) {
+	if objective <= 0 || objective >= 100 {
+		return nil, &ValidationError{Field: "objective", Message: fmt.Sprintf("must be between 0 and 100 exclusive, got %v", objective)}
+	}
+	if len(windows) == 0 {
+		windows = []BurnRateWindow{FastBurnWindow, SlowBurnWindow}
+	}
+	alerts := make([]BurnRateAlert, 0, len(windows))
+	for _, window := range windows {
+		alert := BurnRateAlert{
+			Window:        window,
+			LongBurnRate:  windowBurnRate(results, objective, now, window.Long),
+			ShortBurnRate: windowBurnRate(results, objective, now, window.Short),
+		}
+		alert.Firing = alert.LongBurnRate > window.Threshold && alert.ShortBurnRate > window.Threshold
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+// windowBurnRate returns the burn rate of the results within window of now, or 0 if there are none.
+func windowBurnRate(results []EndpointResult, objective float64, now time.Time, window time.Duration) float64 {
+	start := now.Add(-window)
+	var total, successes int
+	for _, result := range results {
+		if !result.Timestamp.After(start) || result.Timestamp.After(now) {
+			continue
+		}
+		total++
+		if result.Success {
+			successes++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return BurnRate(float64(successes)/float64(total)*100, objective)
+}
@@ -0,0 +1,92 @@
+package gatussdk
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBurnRate(t *testing.T) {
+	tests := []struct {
+		uptime, objective, expected float64
+	}{
+		{uptime: 100, objective: 99.9, expected: 0},
+		{uptime: 99.9, objective: 99.9, expected: 1},
+		{uptime: 98.56, objective: 99.9, expected: 14.4},
+		{uptime: 0, objective: 99, expected: 100},
+	}
+	for _, tt := range tests {
+		if burnRate := BurnRate(tt.uptime, tt.objective); math.Abs(burnRate-tt.expected) > 1e-9 {
+			t.Errorf("BurnRate(%v, %v) = %v, want %v", tt.uptime, tt.objective, burnRate, tt.expected)
+		}
+	}
+}
+
+func TestEvaluateBurnRates(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	// results creates one result per minute over the last 6 hours, failing within the given minutes before now
+	results := func(failing func(minutesAgo int) bool) []EndpointResult {
+		var results []EndpointResult
+		for minutesAgo := 359; minutesAgo >= 0; minutesAgo-- {
+			results = append(results, EndpointResult{Success: !failing(minutesAgo), Timestamp: now.Add(-time.Duration(minutesAgo) * time.Minute)})
+		}
+		return results
+	}
+	tests := []struct {
+		name           string
+		results        []EndpointResult
+		expectedFiring map[string]bool
+	}{
+		{
+			name:           "healthy",
+			results:        results(func(int) bool { return false }),
+			expectedFiring: map[string]bool{"fast": false, "slow": false},
+		},
+		{
+			name:           "ongoing outage",
+			results:        results(func(minutesAgo int) bool { return minutesAgo < 10 }),
+			expectedFiring: map[string]bool{"fast": true, "slow": true},
+		},
+		{
+			name: "recovered outage",
+			// A third of the last hour failed, but the last 5 minutes succeeded, so the fast burn alert reset
+			results:        results(func(minutesAgo int) bool { return minutesAgo >= 10 && minutesAgo < 30 }),
+			expectedFiring: map[string]bool{"fast": false, "slow": true},
+		},
+		{
+			name:           "no results",
+			expectedFiring: map[string]bool{"fast": false, "slow": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts, err := EvaluateBurnRates(tt.results, 99.9, now)
+			if err != nil {
+				t.Fatalf("EvaluateBurnRates() error = %v", err)
+			}
+			if len(alerts) != 2 {
+				t.Fatalf("got %d alerts, want 2", len(alerts))
+			}
+			for _, alert := range alerts {
+				if alert.Firing != tt.expectedFiring[alert.Window.Name] {
+					t.Errorf("%s: Firing = %v, want %v (%+v)", alert.Window.Name, alert.Firing, tt.expectedFiring[alert.Window.Name], alert)
+				}
+			}
+		})
+	}
+
+	alerts, err := EvaluateBurnRates(results(func(minutesAgo int) bool { return minutesAgo < 3 }), 99, now, BurnRateWindow{Name: "custom", Long: 10 * time.Minute, Short: 5 * time.Minute, Threshold: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 3 failures out of 10 results is a burn rate of 30, below the threshold despite 3 out of 5 being 60
+	if len(alerts) != 1 || alerts[0].Firing || math.Abs(alerts[0].LongBurnRate-30) > 1e-9 || math.Abs(alerts[0].ShortBurnRate-60) > 1e-9 {
+		t.Errorf("unexpected alerts: %+v", alerts)
+	}
+
+	var validationErr *ValidationError
+	if _, err := EvaluateBurnRates(nil, 100, now); !errors.As(err, &validationErr) || validationErr.Field != "objective" {
+		t.Errorf("expected validation error for objective, got %v", err)
+	}
+}
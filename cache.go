@@ -0,0 +1,153 @@
+package gatussdk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxCachedResponses is the maximum number of responses cached by WithConditionalRequests. When the cache is full,
+// the least recently used response is evicted.
+const maxCachedResponses = 256
+
+// WithConditionalRequests enables conditional GET requests. Responses carrying a Last-Modified or ETag header
+// are cached, and requesting the same URL again sends If-Modified-Since and If-None-Match headers. If the server
+// or a proxy in front of it answers 304 Not Modified, the cached response is returned instead.
+//
+// At most 256 responses are cached, evicting the least recently used ones. Gatus itself may not send these headers,
+// in which case nothing is cached. Use WithNotModified to find out
+// whether a result was served from the cache. Cached responses are counted as cache hits in Stats.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithConditionalRequests(true))
+func WithConditionalRequests(enabled bool) ClientOption {
+	return func(c *Client) {
+		if enabled {
+			c.cache = &responseCache{entries: make(map[[sha256.Size]byte]*cacheEntry)}
+		} else {
+			c.cache = nil
+		}
+	}
+}
+
+// WithNotModified reports whether a single request was answered with 304 Not Modified, in which case the
+// result is the snapshot cached by a previous request. It requires WithConditionalRequests.
+//
+// Example:
+//
+//	var notModified bool
+//	statuses, err := client.GetAllEndpointStatuses(ctx, WithNotModified(&notModified))
+//	if err == nil && notModified {
+//	    return // Nothing changed since the last refresh
+//	}
+func WithNotModified(notModified *bool) RequestOption {
+	return func(o *requestOptions) {
+		o.notModified = notModified
+	}
+}
+
+// responseCache holds the last response with validators for each request, keyed by the hash of its request key
+// (see requestKey), so that the credentials in the key are not kept in memory.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*cacheEntry
+	uses    uint64
+}
+
+// cacheEntry is a cached response.
+type cacheEntry struct {
+	resp *http.Response
+	body []byte
+	// used is the value of the use counter of the cache when the entry was last used.
+	used uint64
+}
+
+// get returns the entry cached for key, if any, marking it as used.
+func (c *responseCache) get(key [sha256.Size]byte) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := c.entries[key]
+	if entry != nil {
+		c.uses++
+		entry.used = c.uses
+	}
+	return entry
+}
+
+// put caches entry for key, evicting the least recently used entry if the cache is full.
+func (c *responseCache) put(key [sha256.Size]byte, entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxCachedResponses {
+		var oldest [sha256.Size]byte
+		var oldestUse uint64
+		first := true
+		for k, e := range c.entries {
+			if first || e.used < oldestUse {
+				oldest, oldestUse, first = k, e.used, false
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.uses++
+	entry.used = c.uses
+	c.entries[key] = entry
+}
+
+// sendInfo describes where the response returned by send came from.
+type sendInfo struct {
+	// shared is whether the response was shared with an identical request in flight (see WithSingleflight).
+	shared bool
+	// notModified is whether the response was served from the cache after a 304 Not Modified.
+	notModified bool
+}
+
+// fetch sends req with the client's HTTP client, making it conditional if a cached response exists for it.
+func (c *Client) fetch(req *http.Request) (*http.Response, sendInfo, error) {
+	if c.cache == nil || req.Method != http.MethodGet {
+		resp, err := c.httpClient.Do(req)
+		return resp, sendInfo{}, err
+	}
+	key := sha256.Sum256([]byte(requestKey(req)))
+	entry := c.cache.get(key)
+	if entry != nil {
+		if lastModified := entry.resp.Header.Get("Last-Modified"); lastModified != "" && req.Header.Get("If-Modified-Since") == "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+		if etag := entry.resp.Header.Get("ETag"); etag != "" && req.Header.Get("If-None-Match") == "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, sendInfo{}, err
+	}
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		c.stats.cacheHits.Add(1)
+		return entry.response(req), sendInfo{notModified: true}, nil
+	}
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get("Last-Modified") == "" && resp.Header.Get("ETag") == "") {
+		return resp, sendInfo{}, nil
+	}
+	body, err := c.readBody(resp)
+	if err != nil {
+		return nil, sendInfo{}, err
+	}
+	entry = &cacheEntry{resp: resp, body: body}
+	c.cache.put(key, entry)
+	return entry.response(req), sendInfo{}, nil
+}
+
+// response returns a copy of the cached response for req, with its own body.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	resp := *e.resp
+	resp.Header = e.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(e.body))
+	resp.Request = req
+	return &resp
+}
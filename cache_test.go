@@ -0,0 +1,140 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithConditionalRequests(t *testing.T) {
+	scenarios := []struct {
+		name      string
+		validator string
+		condition string
+	}{
+		{name: "last-modified", validator: "Last-Modified", condition: "If-Modified-Since"},
+		{name: "etag", validator: "ETag", condition: "If-None-Match"},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			version := "Mon, 01 Jan 2024 00:00:00 GMT"
+			key := "core_api"
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get(scenario.condition) == version {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set(scenario.validator, version)
+				json.NewEncoder(w).Encode([]EndpointStatus{{Key: key}})
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, WithConditionalRequests(true))
+			fetch := func() ([]EndpointStatus, bool) {
+				var notModified bool
+				statuses, err := client.GetAllEndpointStatuses(context.Background(), WithNotModified(&notModified))
+				if err != nil {
+					t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+				}
+				return statuses, notModified
+			}
+			if statuses, notModified := fetch(); notModified || statuses[0].Key != "core_api" {
+				t.Errorf("first fetch: got %+v, notModified=%v", statuses, notModified)
+			}
+			if statuses, notModified := fetch(); !notModified || len(statuses) != 1 || statuses[0].Key != "core_api" {
+				t.Errorf("second fetch: got %+v, notModified=%v, want cached snapshot", statuses, notModified)
+			}
+			version, key = "Tue, 02 Jan 2024 00:00:00 GMT", "core_web"
+			if statuses, notModified := fetch(); notModified || statuses[0].Key != "core_web" {
+				t.Errorf("third fetch: got %+v, notModified=%v, want fresh result", statuses, notModified)
+			}
+			if stats := client.Stats(); stats.CacheHits != 1 {
+				t.Errorf("CacheHits = %d, want 1", stats.CacheHits)
+			}
+		})
+	}
+}
+
+func TestWithConditionalRequests_Disabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") != "" {
+			t.Error("expected no conditional request")
+		}
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+			t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+		}
+	}
+}
+
+func TestWithConditionalRequests_Bounded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == "v1" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", "v1")
+		w.Write([]byte(`{"key": "core_api"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithConditionalRequests(true), WithBearerToken("secret"))
+	fetch := func(key string) bool {
+		var notModified bool
+		if _, err := client.GetEndpointStatusByKey(context.Background(), key, WithNotModified(&notModified)); err != nil {
+			t.Fatalf("GetEndpointStatusByKey() error = %v", err)
+		}
+		return notModified
+	}
+	fetch("core_first")
+	for i := range maxCachedResponses {
+		if i == maxCachedResponses/2 {
+			// Using the first response keeps it cached
+			fetch("core_first")
+		}
+		fetch(fmt.Sprintf("core_endpoint-%d", i))
+	}
+	if len(client.cache.entries) != maxCachedResponses {
+		t.Errorf("expected %d cached responses, got %d", maxCachedResponses, len(client.cache.entries))
+	}
+	if !fetch("core_first") {
+		t.Error("expected the recently used response to be cached")
+	}
+	if fetch("core_endpoint-0") {
+		t.Error("expected the least recently used response to be evicted")
+	}
+}
+
+func TestWithConditionalRequests_MaxResponseSize(t *testing.T) {
+	// The server streams an endless body, so reading it whole would never end
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", "v1")
+		chunk := make([]byte, 32*1024)
+		for r.Context().Err() == nil {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithConditionalRequests(true), WithMaxResponseSize(1024))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := client.GetAllEndpointStatuses(ctx)
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Errorf("expected ResponseTooLargeError, got %v", err)
+	}
+}
@@ -1,12 +1,17 @@
 package gatussdk
 
 import (
-	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/http/httptrace"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -16,6 +21,10 @@ const (
 	DefaultTimeout = 30 * time.Second
 	// DefaultUserAgent is the default User-Agent header value.
 	DefaultUserAgent = "GatusSDK/1.0"
+	// DefaultMaxIdleConnsPerHost is the default maximum number of idle connections kept per host.
+	DefaultMaxIdleConnsPerHost = 10
+	// DefaultIdleConnTimeout is the default maximum amount of time an idle connection is kept open.
+	DefaultIdleConnTimeout = 90 * time.Second
 )
 
 // Client is the main client for interacting with the Gatus API.
@@ -23,6 +32,33 @@ type Client struct {
 	baseURL    string
 	httpClient *http.Client
 	userAgent  string
+	logger     *slog.Logger
+
+	metricsHook      func(MetricsEvent)
+	clientTrace      *httptrace.ClientTrace
+	batchConcurrency int
+	decompressors    map[string]Decompressor
+	encodings        []string
+	maxResponseSize  int64
+	requestTimeout   time.Duration
+	clock            Clock
+
+	token              string
+	tokens             *tokenCache
+	generateRequestIDs bool
+	retryPolicy        RetryPolicy
+	flights            *flightGroup
+	cache              *responseCache
+	limiter            chan struct{}
+	flapping           FlappingOptions
+	polling            PollingOptions
+	netDialer          *net.Dialer
+	transportDial      func(ctx context.Context, network, addr string) (net.Conn, error)
+	dialTransport      *http.Transport
+	ownedTransport     *http.Transport
+	hostOverrides      map[string]string
+
+	stats clientStats
 }
 
 // ClientOption is a function that configures a Client.
@@ -35,20 +71,24 @@ type ClientOption func(*Client)
 //	client := NewClient("https://status.example.org")
 //	client := NewClient("https://status.example.org", WithTimeout(10*time.Second))
 func NewClient(baseURL string, opts ...ClientOption) *Client {
-	// Remove trailing slash from base URL
-	baseURL = strings.TrimSuffix(baseURL, "/")
+	// Remove trailing slashes from base URL
+	baseURL = strings.TrimRight(baseURL, "/")
 
+	transport := newDefaultTransport()
 	client := &Client{
 		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
-			Transport: &http.Transport{
-				MaxIdleConns:        100,
-				MaxIdleConnsPerHost: 10,
-				IdleConnTimeout:     90 * time.Second,
-			},
+			Timeout:   DefaultTimeout,
+			Transport: transport,
 		},
-		userAgent: DefaultUserAgent,
+		ownedTransport: transport,
+		userAgent:      DefaultUserAgent,
+		logger:         slog.New(slog.DiscardHandler),
+
+		batchConcurrency: DefaultBatchConcurrency,
+		decompressors:    defaultDecompressors(),
+		encodings:        []string{"gzip"},
+		clock:            systemClock{},
 	}
 
 	// Apply options
@@ -59,6 +99,68 @@ func NewClient(baseURL string, opts ...ClientOption) *Client {
 	return client
 }
 
+// NewClientE creates a new Gatus API client like NewClient, but validates the base URL up front instead of
+// letting an invalid URL surface later as a confusing network error.
+// A *ValidationError is returned if the base URL is not an absolute http or https URL with a host.
+//
+// Example:
+//
+//	client, err := NewClientE(os.Getenv("GATUS_URL"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewClientE(baseURL string, opts ...ClientOption) (*Client, error) {
+	if err := validateBaseURL(baseURL); err != nil {
+		return nil, err
+	}
+	return NewClient(baseURL, opts...), nil
+}
+
+// validateBaseURL checks that baseURL is an absolute http or https URL with a host.
+func validateBaseURL(baseURL string) error {
+	if baseURL == "" {
+		return &ValidationError{
+			Field:   "baseURL",
+			Message: "cannot be empty",
+		}
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return &ValidationError{
+			Field:   "baseURL",
+			Message: fmt.Sprintf("invalid URL %q: %v", baseURL, err),
+		}
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return &ValidationError{
+			Field:   "baseURL",
+			Message: fmt.Sprintf("scheme must be http or https, got %q", u.Scheme),
+		}
+	}
+	if u.Host == "" {
+		return &ValidationError{
+			Field:   "baseURL",
+			Message: "host cannot be empty",
+		}
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return &ValidationError{
+			Field:   "baseURL",
+			Message: "cannot contain a query or fragment",
+		}
+	}
+	return nil
+}
+
+// newDefaultTransport creates the transport used by clients that don't specify their own.
+func newDefaultTransport() *http.Transport {
+	return &http.Transport{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client for the Gatus client.
 //
 // Example:
@@ -82,6 +184,184 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithDefaultRequestTimeout sets a timeout applied to every request made by a method of the client, covering the
+// time until the response body is fully read, like WithRequestTimeout does for a single request. Unlike WithTimeout,
+// it is enforced through the context of each request, independently of the HTTP client shared with other code,
+// so long-lived clients can keep individual calls snappy. WithRequestTimeout overrides it for a single request.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithDefaultRequestTimeout(2*time.Second))
+//	// This request may take longer
+//	statuses, err := client.GetAllEndpointStatusesAllPages(ctx, 100, WithRequestTimeout(time.Minute))
+func WithDefaultRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to perform requests, while keeping the other
+// settings of the HTTP client, such as the timeout.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithTransport(myInstrumentedTransport))
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithMaxIdleConnsPerHost sets the maximum number of idle connections kept per host.
+// Defaults to DefaultMaxIdleConnsPerHost.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithMaxIdleConnsPerHost(50))
+func WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) ClientOption {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+		}
+	}
+}
+
+// WithIdleConnTimeout sets the maximum amount of time an idle connection is kept open.
+// Defaults to DefaultIdleConnTimeout.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithIdleConnTimeout(30*time.Second))
+func WithIdleConnTimeout(idleConnTimeout time.Duration) ClientOption {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.IdleConnTimeout = idleConnTimeout
+		}
+	}
+}
+
+// WithHTTP2 forces the use of HTTP/2 when enabled is true, or restricts the client to HTTP/1.1 when enabled is false.
+// By default, HTTP/2 is negotiated with servers supporting it unless the TLS or dial settings of the transport are customized.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithHTTP2(false))
+func WithHTTP2(enabled bool) ClientOption {
+	return func(c *Client) {
+		transport := c.transport()
+		if transport == nil {
+			return
+		}
+		if enabled {
+			transport.ForceAttemptHTTP2 = true
+			transport.Protocols = nil
+			return
+		}
+		transport.ForceAttemptHTTP2 = false
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetHTTP1(true)
+	}
+}
+
+// WithKeepAlives enables or disables HTTP keep-alives. When disabled, a new connection is used for every request,
+// which can help when a proxy in front of Gatus mishandles persistent connections. Keep-alives are enabled by default.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithKeepAlives(false))
+func WithKeepAlives(enabled bool) ClientOption {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.DisableKeepAlives = !enabled
+		}
+	}
+}
+
+// WithInsecureSkipTLSVerify disables the verification of the certificate presented by Gatus.
+//
+// This is intended for development only, e.g. to target a local cluster using a self-signed certificate:
+// it makes the client vulnerable to man-in-the-middle attacks, exposing credentials such as bearer tokens.
+// Never use it in production; trust the certificate authority with a custom transport instead.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://gatus.localhost", WithInsecureSkipTLSVerify())
+func WithInsecureSkipTLSVerify() ClientOption {
+	return func(c *Client) {
+		transport := c.transport()
+		if transport == nil {
+			return
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		} else {
+			transport.TLSClientConfig = transport.TLSClientConfig.Clone()
+		}
+		transport.TLSClientConfig.InsecureSkipVerify = true
+		preserveHTTP2(transport)
+	}
+}
+
+// transport returns the transport of the HTTP client if it is an *http.Transport, or nil otherwise.
+// A transport provided with WithTransport or WithHTTPClient is cloned, along with its HTTP client, the first time
+// it is returned, so that options never modify a transport shared with the rest of the program, such as
+// http.DefaultTransport.
+func (c *Client) transport() *http.Transport {
+	transport, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok {
+		return nil
+	}
+	if transport != c.ownedTransport {
+		transport = transport.Clone()
+		httpClient := *c.httpClient
+		httpClient.Transport = transport
+		c.httpClient = &httpClient
+		c.ownedTransport = transport
+	}
+	return transport
+}
+
+// WithBasePath sets the path under which Gatus is served, for instances deployed under a sub-path behind a
+// reverse proxy. It replaces any path included in the base URL. The path is normalized, so leading, trailing
+// and repeated slashes are ignored (e.g. "status", "/status/" and "//status" are equivalent).
+//
+// Example:
+//
+//	// Requests are sent to https://example.com/status/api/v1/...
+//	client := NewClient("https://example.com", WithBasePath("/status/"))
+func WithBasePath(basePath string) ClientOption {
+	return func(c *Client) {
+		basePath = cleanBasePath(basePath)
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			c.baseURL = strings.TrimRight(c.baseURL, "/") + basePath
+			return
+		}
+		u.Path, u.RawPath = basePath, ""
+		c.baseURL = strings.TrimRight(u.String(), "/")
+	}
+}
+
+// cleanBasePath returns basePath with a single leading slash, no trailing slash and no empty segments,
+// or an empty string if basePath has no segments.
+func cleanBasePath(basePath string) string {
+	var segments []string
+	for _, segment := range strings.Split(basePath, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return ""
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
 // WithUserAgent sets a custom User-Agent header for all requests.
 //
 // Example:
@@ -93,33 +373,82 @@ func WithUserAgent(userAgent string) ClientOption {
 	}
 }
 
-// doRequest performs an HTTP request with the configured client settings.
-func (c *Client) doRequest(ctx context.Context, method, path string) (*http.Response, error) {
-	url := c.baseURL + path
+// WithBearerToken sets a bearer token sent in the Authorization header of every request, for instances
+// whose API is protected, e.g. by a reverse proxy. Methods taking their own token, such as
+// PushExternalEndpointResult, send that token instead.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithBearerToken(os.Getenv("GATUS_TOKEN")))
+func WithBearerToken(token string) ClientOption {
+	return func(c *Client) {
+		c.token = token
+	}
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+// WithLogger sets a structured logger used to log requests, responses and decoding failures at debug level.
+// Background loops, such as RecordHistory and EmitStatsD, also log the failures they recover from at warn level.
+// Credentials such as bearer tokens are never logged. By default, nothing is logged.
+//
+// Example:
+//
+//	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+//	client := NewClient("https://status.example.org", WithLogger(logger))
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		if logger == nil {
+			logger = slog.New(slog.DiscardHandler)
+		}
+		c.logger = logger
 	}
+}
 
-	// Set headers
-	req.Header.Set("User-Agent", c.userAgent)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Accept-Encoding", "gzip")
+// WithBatchConcurrency sets the maximum number of concurrent requests made by batch methods
+// such as GetUptimes and GetEndpointStatusesByKeys. Defaults to DefaultBatchConcurrency.
+// Values lower than 1 are ignored.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithBatchConcurrency(4))
+func WithBatchConcurrency(concurrency int) ClientOption {
+	return func(c *Client) {
+		if concurrency > 0 {
+			c.batchConcurrency = concurrency
+		}
+	}
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("executing request: %w", err)
+// WithMaxResponseSize sets the maximum size, in bytes, of a decompressed response body.
+// Decoding a larger response fails with a *ResponseTooLargeError, which protects the calling service
+// from running out of memory if the base URL points at something other than Gatus.
+// By default, or if maxResponseSize is zero or negative, the size of responses is not limited.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithMaxResponseSize(10<<20)) // 10 MiB
+func WithMaxResponseSize(maxResponseSize int64) ClientOption {
+	return func(c *Client) {
+		c.maxResponseSize = maxResponseSize
 	}
+}
 
-	return resp, nil
+// doRequest performs an HTTP request with the configured client settings.
+func (c *Client) doRequest(ctx context.Context, method, path string, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, method, path, nil, "", opts)
 }
 
 // doRequestWithAuth performs an HTTP request with the configured client settings and Bearer authentication.
-func (c *Client) doRequestWithAuth(ctx context.Context, method, path string, token string) (*http.Response, error) {
+func (c *Client) doRequestWithAuth(ctx context.Context, method, path string, token string, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, method, path, nil, token, opts)
+}
+
+// do performs an HTTP request with the configured client settings.
+// If body is not nil, it is sent as a JSON request body.
+// If token is not empty, it is sent as a Bearer token in the Authorization header instead of the client's token.
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, token string, opts []RequestOption) (*http.Response, error) {
 	url := c.baseURL + path
 
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
 	}
@@ -127,56 +456,286 @@ func (c *Client) doRequestWithAuth(ctx context.Context, method, path string, tok
 	// Set headers
 	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Accept-Encoding", "gzip")
-	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept-Encoding", c.acceptEncoding())
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if requestID := c.requestID(ctx); requestID != "" {
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+	providedToken := ""
+	if token == "" && c.tokens != nil {
+		if providedToken, err = c.tokens.get(ctx); err != nil {
+			return nil, fmt.Errorf("fetching token: %w", err)
+		}
+		token = providedToken
+	}
+	if token == "" {
+		token = c.token
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	options := newRequestOptions(opts)
+	if options.timeout <= 0 {
+		options.timeout = c.requestTimeout
+	}
+	req, cancel := options.apply(req)
+	req, timings := c.trace(req)
+	release, err := c.acquire(req.Context())
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	cancel = releaseAndCancel(release, cancel)
+	logger := c.logger
+	if requestID := req.Header.Get(RequestIDHeader); requestID != "" {
+		logger = logger.With("request_id", requestID)
+	}
 
-	resp, err := c.httpClient.Do(req)
+	logger.DebugContext(ctx, "sending request", "method", method, "url", redactURL(req.URL))
+	start := time.Now()
+	var resp *http.Response
+	var info sendInfo
+	retries := 0
+	for {
+		resp, info, err = c.send(req)
+		err = redactError(err, req)
+		if providedToken != "" && err == nil && resp.StatusCode == http.StatusUnauthorized {
+			logger.DebugContext(ctx, "refreshing token", "method", method, "url", redactURL(req.URL))
+			refreshed, refreshErr := c.refreshToken(req, resp, providedToken)
+			// Only retry once per request
+			providedToken = ""
+			if refreshErr != nil {
+				resp, err = nil, fmt.Errorf("refreshing token: %w", refreshErr)
+				break
+			}
+			if refreshed {
+				continue
+			}
+		}
+		delay, retry := c.retryDelay(req, resp, err, retries, options.idempotent)
+		if !retry {
+			break
+		}
+		logger.DebugContext(ctx, "retrying request", "method", method, "url", redactURL(req.URL), "retry", retries+1, "delay", delay, "error", err)
+		if retryErr := c.prepareRetry(req.Context(), req, resp, delay); retryErr != nil {
+			resp, err = nil, retryErr
+			break
+		}
+		retries++
+	}
+	duration := time.Since(start)
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	c.stats.recordResponse(statusCode, retries, err)
 	if err != nil {
+		logger.DebugContext(ctx, "request failed", "method", method, "url", redactURL(req.URL), "duration", duration, "retries", retries, "error", err)
+		c.emitMetrics(MetricsEvent{Method: method, PathTemplate: pathTemplate(path), Duration: duration, Retries: retries, Timings: timings.result(), Err: err})
+		cancel()
 		return nil, fmt.Errorf("executing request: %w", err)
 	}
+	if options.notModified != nil {
+		*options.notModified = info.notModified
+	}
+	if !info.shared && !info.notModified {
+		resp.Body = &countingBody{ReadCloser: resp.Body, counter: &c.stats.bytesReceived}
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	logger.DebugContext(ctx, "received response", "method", method, "url", redactURL(req.URL), "status", resp.StatusCode, "duration", duration, "retries", retries)
+	c.emitMetrics(MetricsEvent{Method: method, PathTemplate: pathTemplate(path), StatusCode: resp.StatusCode, Duration: duration, Retries: retries, Timings: timings.result()})
 
 	return resp, nil
 }
 
-// decodeResponse decodes the HTTP response body, handling gzip compression if present.
+// releaseAndCancel returns a function calling release and cancel.
+func releaseAndCancel(release func(), cancel context.CancelFunc) context.CancelFunc {
+	return func() {
+		release()
+		cancel()
+	}
+}
+
+// decodeResponse decodes the HTTP response body, handling compression if present.
 func (c *Client) decodeResponse(resp *http.Response, v interface{}) error {
 	defer resp.Body.Close()
 
-	var reader io.Reader = resp.Body
-
-	// Handle gzip compression
-	if resp.Header.Get("Content-Encoding") == "gzip" {
-		gzReader, err := gzip.NewReader(resp.Body)
-		if err != nil {
-			return fmt.Errorf("creating gzip reader: %w", err)
-		}
-		defer gzReader.Close()
-		reader = gzReader
+	// Handle compression
+	reader, err := c.decompress(resp.Body, resp.Header.Get("Content-Encoding"))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	var content io.Reader = reader
+	if c.maxResponseSize > 0 {
+		content = &maxSizeReader{reader: reader, limit: c.maxResponseSize}
 	}
 
 	// Check for non-2xx status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(reader)
-		return &APIError{
+		body, err := io.ReadAll(content)
+		apiErr := &APIError{
 			StatusCode: resp.StatusCode,
 			Message:    http.StatusText(resp.StatusCode),
-			Body:       string(body),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), c.clock.Now()),
+			Err:        err,
 		}
+		apiErr.setBody(body)
+		if isNonJSONBody(resp.Header.Get("Content-Type"), body) {
+			nonJSONErr := newNonJSONResponseError(resp, body)
+			apiErr.Body = nonJSONErr.Preview
+			if apiErr.Err == nil {
+				apiErr.Err = nonJSONErr
+			}
+		}
+		apiErr.setRequest(resp.Request)
+		return apiErr
 	}
 
-	// For empty responses (like 204 No Content), don't try to decode
-	if resp.StatusCode == http.StatusNoContent {
+	// For empty responses (like 204 No Content) or when the caller doesn't need the body, don't try to decode
+	if resp.StatusCode == http.StatusNoContent || v == nil {
 		return nil
 	}
 
-	// Decode JSON response
-	if err := json.NewDecoder(reader).Decode(v); err != nil {
+	// Decode JSON response, capturing the beginning of the body to describe non-JSON responses
+	captured := &captureWriter{limit: maxNonJSONCapture}
+	if err := json.NewDecoder(io.TeeReader(content, captured)).Decode(v); err != nil {
 		// Check if it's EOF from empty response body
 		if err == io.EOF {
 			return nil
 		}
+		nonJSON := isNonJSONBody(resp.Header.Get("Content-Type"), captured.data)
+		if nonJSON {
+			err = newNonJSONResponseError(resp, captured.data)
+		}
+		if resp.Request != nil {
+			logger := c.logger
+			if requestID := resp.Request.Header.Get(RequestIDHeader); requestID != "" {
+				logger = logger.With("request_id", requestID)
+			}
+			logger.DebugContext(resp.Request.Context(), "failed to decode response", "method", resp.Request.Method, "url", redactURL(resp.Request.URL), "status", resp.StatusCode, "error", err)
+		}
+		c.stats.decodeErrs.Add(1)
+		if nonJSON {
+			return err
+		}
 		return fmt.Errorf("decoding response: %w", err)
 	}
 
 	return nil
 }
+
+// redactURL returns the string representation of u with credentials removed.
+// The password of the user info and the values of sensitive query parameters are replaced with "xxxxx".
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	if redacted.RawQuery != "" {
+		query := redacted.Query()
+		for name := range query {
+			if isSensitiveParameter(name) {
+				query.Set(name, "xxxxx")
+			}
+		}
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.Redacted()
+}
+
+// isSensitiveParameter returns whether a query parameter with the given name may carry a credential.
+func isSensitiveParameter(name string) bool {
+	name = strings.ToLower(name)
+	for _, sensitive := range []string{"token", "password", "secret", "auth", "key"} {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestSecrets returns the credentials sent with req: the bearer token or basic auth credentials of the
+// Authorization header, the password of the URL user info and the values of sensitive query parameters.
+func requestSecrets(req *http.Request) []string {
+	var secrets []string
+	authorization := req.Header.Get("Authorization")
+	if scheme, credentials, ok := strings.Cut(authorization, " "); ok {
+		secrets = append(secrets, credentials)
+		if strings.EqualFold(scheme, "Basic") {
+			if decoded, err := base64.StdEncoding.DecodeString(credentials); err == nil {
+				if _, password, ok := strings.Cut(string(decoded), ":"); ok {
+					secrets = append(secrets, password)
+				}
+			}
+		}
+	}
+	if req.URL != nil {
+		if password, ok := req.URL.User.Password(); ok {
+			secrets = append(secrets, password)
+		}
+		for name, values := range req.URL.Query() {
+			if isSensitiveParameter(name) {
+				secrets = append(secrets, values...)
+			}
+		}
+	}
+	return secrets
+}
+
+// redactSecrets returns s with every occurrence of the given secrets replaced with "xxxxx".
+func redactSecrets(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, "xxxxx")
+		}
+	}
+	return s
+}
+
+// redactError returns err with the URL of a *url.Error redacted, since the errors returned by http.Client
+// only strip the password of the URL user info.
+func redactError(err error, req *http.Request) error {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+	redacted := *urlErr
+	redacted.URL = redactURL(req.URL)
+	return &redacted
+}
+
+// readBody reads and closes the body of resp as received, before decompression, failing with a
+// ResponseTooLargeError as soon as it exceeds the maximum response size, if any, so that oversized responses are
+// never buffered in memory. Since compression shrinks bodies, a compressed body exceeding the limit would exceed it
+// once decompressed.
+func (c *Client) readBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	if c.maxResponseSize > 0 {
+		return io.ReadAll(&maxSizeReader{reader: resp.Body, limit: c.maxResponseSize})
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// maxSizeReader is a reader returning a *ResponseTooLargeError once more than limit bytes have been read.
+type maxSizeReader struct {
+	reader io.Reader
+	limit  int64
+	read   int64
+}
+
+// Read reads from the underlying reader, failing if the limit is exceeded.
+func (r *maxSizeReader) Read(p []byte) (int, error) {
+	// Never read more than one byte past the limit
+	if remaining := r.limit - r.read + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.read > r.limit {
+		return n, &ResponseTooLargeError{Limit: r.limit}
+	}
+	return n, err
+}
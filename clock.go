@@ -0,0 +1,81 @@
+package gatussdk
+
+import "time"
+
+// Clock provides the current time and the timers used by time-dependent components of the client, such as
+// watchers, recorders, retry backoff and badge caching. It can be replaced with WithClock so that the logic of
+// these components can be unit tested deterministically, without real sleeps (see gatustest.FakeClock).
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer creates a timer firing once after d.
+	NewTimer(d time.Duration) Timer
+	// NewTicker creates a ticker firing every d. d must be greater than zero.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a timer created by a Clock, mirroring *time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. It returns false if the timer already fired or was stopped.
+	Stop() bool
+}
+
+// Ticker is a ticker created by a Clock, mirroring *time.Ticker.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// WithClock sets the clock used by time-dependent components of the client. Defaults to the system clock.
+// It is meant for tests; see gatustest.FakeClock.
+//
+// Example:
+//
+//	clock := gatustest.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+//	client := NewClient(server.URL, WithClock(clock))
+//	events := client.WatchSuite(ctx, "_check-authentication", time.Minute)
+//	<-events
+//	clock.Advance(time.Minute) // Triggers the next poll immediately
+func WithClock(clock Clock) ClientOption {
+	return func(c *Client) {
+		if clock == nil {
+			clock = systemClock{}
+		}
+		c.clock = clock
+	}
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+type systemTicker struct {
+	*time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
@@ -0,0 +1,70 @@
+package gatussdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingClock is a Clock whose timers fire immediately, recording their durations.
+type recordingClock struct {
+	now time.Time
+
+	mu     sync.Mutex
+	timers []time.Duration
+}
+
+func (c *recordingClock) Now() time.Time {
+	return c.now
+}
+
+func (c *recordingClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	c.timers = append(c.timers, d)
+	c.mu.Unlock()
+	return systemTimer{time.NewTimer(0)}
+}
+
+func (c *recordingClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+func TestWithClock(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			// Retry-After dates are relative to the client clock
+			w.Header().Set("Retry-After", now.Add(20*time.Minute).Format(http.TimeFormat))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	clock := &recordingClock{now: now}
+	client := NewClient(server.URL, WithClock(clock), WithRetry(RetryPolicy{MaxRetries: 1, InitialBackoff: time.Second, MaxBackoff: time.Hour}))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if len(clock.timers) != 1 || clock.timers[0] != 20*time.Minute {
+		t.Errorf("expected the retry to wait 20m on the client clock, got %v", clock.timers)
+	}
+
+	snapshot, err := client.TakeSnapshot(context.Background())
+	if err != nil {
+		t.Fatalf("TakeSnapshot() error = %v", err)
+	}
+	if !snapshot.Timestamp.Equal(now) {
+		t.Errorf("Timestamp = %v, want %v", snapshot.Timestamp, now)
+	}
+
+	if _, ok := NewClient(server.URL, WithClock(nil)).clock.(systemClock); !ok {
+		t.Error("expected WithClock(nil) to use the system clock")
+	}
+}
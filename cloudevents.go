@@ -0,0 +1,173 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// CloudEventTypeEndpointTransition is the type of the CloudEvents emitted when an endpoint goes up or down.
+	CloudEventTypeEndpointTransition = EventTypeEndpointTransition
+	// CloudEventTypeGroupTransition is the type of the CloudEvents emitted when the aggregate health of a group changes.
+	CloudEventTypeGroupTransition = EventTypeGroupTransition
+	// DefaultCloudEventSource is the default source of the CloudEvents emitted by CloudEventEmitter.
+	DefaultCloudEventSource = "gatus-sdk"
+)
+
+// CloudEvent is an event in the structured JSON format of the CloudEvents 1.0 specification.
+type CloudEvent struct {
+	// SpecVersion is the version of the CloudEvents specification, "1.0".
+	SpecVersion string `json:"specversion"`
+	// ID identifies the event. Together with Source, it is unique for each distinct event.
+	ID string `json:"id"`
+	// Source identifies the context in which the event happened, e.g. the URL of the Gatus instance.
+	Source string `json:"source"`
+	// Type is the type of the event, e.g. CloudEventTypeEndpointTransition.
+	Type string `json:"type"`
+	// Subject is the subject of the event within the source: the key of the endpoint, or the name of the group.
+	Subject string `json:"subject,omitempty"`
+	// Time is when the event happened, if known.
+	Time time.Time `json:"time,omitzero"`
+	// DataContentType is the media type of Data.
+	DataContentType string `json:"datacontenttype,omitempty"`
+	// Data is the payload of the event. For transitions, it is a TransitionData encoded as JSON.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// EndpointTransitionCloudEvent wraps event, as emitted by WatchEndpoint, into a CloudEvent from source with the
+// type CloudEventTypeEndpointTransition and the endpoint key as subject. It returns false if event is not a
+// transition (see EndpointTransitionEvent).
+//
+// The ID of the event is derived from the key and the timestamp of the result, so that a result observed by
+// several watchers has a single ID, and consumers can deduplicate events.
+func EndpointTransitionCloudEvent(source string, event EndpointEvent) (CloudEvent, bool) {
+	transition, ok := EndpointTransitionEvent(event)
+	if !ok {
+		return CloudEvent{}, false
+	}
+	return transition.CloudEvent(source), true
+}
+
+// GroupTransitionCloudEvent wraps event, as emitted by WatchGroup, into a CloudEvent from source with the type
+// CloudEventTypeGroupTransition and the group as subject. It returns false if event is not a transition (see
+// GroupTransitionEvent). Since group events don't carry the time of the results that triggered them, the event has
+// a random ID and no time.
+func GroupTransitionCloudEvent(source string, event GroupEvent) (CloudEvent, bool) {
+	transition, ok := GroupTransitionEvent(event)
+	if !ok {
+		return CloudEvent{}, false
+	}
+	return transition.CloudEvent(source), true
+}
+
+// CloudEvent wraps the event into a CloudEvent from source, or DefaultCloudEventSource if source is empty, with
+// the TransitionData of the event as data. Events with a time have an ID derived from their subject and time,
+// and events without one have a random ID.
+func (e Event) CloudEvent(source string) CloudEvent {
+	if source == "" {
+		source = DefaultCloudEventSource
+	}
+	id := newRequestID()
+	if !e.Time.IsZero() {
+		id = e.Subject + "@" + strconv.FormatInt(e.Time.UnixNano(), 10)
+	}
+	// A TransitionData can always be encoded
+	payload, _ := json.Marshal(e.TransitionData)
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              id,
+		Source:          source,
+		Type:            e.Type,
+		Subject:         e.Subject,
+		Time:            e.Time,
+		DataContentType: "application/json",
+		Data:            payload,
+	}
+}
+
+// CloudEventSender delivers CloudEvents, e.g. to an HTTP endpoint or a message broker.
+type CloudEventSender interface {
+	// Send delivers event.
+	Send(ctx context.Context, event CloudEvent) error
+}
+
+// CloudEventSenderFunc is a function implementing CloudEventSender.
+type CloudEventSenderFunc func(ctx context.Context, event CloudEvent) error
+
+// Send calls f.
+func (f CloudEventSenderFunc) Send(ctx context.Context, event CloudEvent) error {
+	return f(ctx, event)
+}
+
+// httpCloudEventSender is the CloudEventSender returned by NewHTTPCloudEventSender.
+type httpCloudEventSender struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewHTTPCloudEventSender returns a CloudEventSender posting events to url in structured content mode, as
+// expected by Knative, Argo Events and other CloudEvents sinks. If httpClient is nil, http.DefaultClient is used.
+func NewHTTPCloudEventSender(url string, httpClient *http.Client) CloudEventSender {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &httpCloudEventSender{url: url, httpClient: httpClient}
+}
+
+func (s *httpCloudEventSender) Send(ctx context.Context, event CloudEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding CloudEvent: %w", err)
+	}
+	if err := postJSON(ctx, s.httpClient, s.url, "application/cloudevents+json; charset=utf-8", body); err != nil {
+		return fmt.Errorf("sending CloudEvent: %w", err)
+	}
+	return nil
+}
+
+// CloudEventEmitter wraps the transitions emitted by watchers into CloudEvents and delivers them with a sender,
+// enabling event-driven automation on outages.
+//
+// Example:
+//
+//	emitter := &CloudEventEmitter{
+//	    Source: "https://status.example.org",
+//	    Sender: NewHTTPCloudEventSender("http://broker-ingress.knative-eventing/default/default", nil),
+//	    OnError: func(event CloudEvent, err error) {
+//	        log.Printf("failed to send %s: %v", event.ID, err)
+//	    },
+//	}
+//	emitter.EmitEndpointEvents(ctx, client.WatchEndpoint(ctx, "core_api", 30*time.Second))
+type CloudEventEmitter struct {
+	// Source is the source of the events. Defaults to DefaultCloudEventSource.
+	Source string
+	// Sender delivers the events.
+	Sender CloudEventSender
+	// OnError, if set, is called with each event that couldn't be delivered. Delivery continues with the next event.
+	OnError func(event CloudEvent, err error)
+}
+
+// EmitEndpointEvents delivers a CloudEvent for each transition among events (see EndpointTransitionCloudEvent),
+// until events is closed or ctx is done.
+func (e *CloudEventEmitter) EmitEndpointEvents(ctx context.Context, events <-chan EndpointEvent) {
+	publishEvents(ctx, PublisherFunc(e.send), events, EndpointTransitionEvent, nil)
+}
+
+// EmitGroupEvents delivers a CloudEvent for each transition among events (see GroupTransitionCloudEvent),
+// until events is closed or ctx is done.
+func (e *CloudEventEmitter) EmitGroupEvents(ctx context.Context, events <-chan GroupEvent) {
+	publishEvents(ctx, PublisherFunc(e.send), events, GroupTransitionEvent, nil)
+}
+
+// send delivers event as a CloudEvent, reporting errors to OnError.
+func (e *CloudEventEmitter) send(ctx context.Context, event Event) error {
+	cloudEvent := event.CloudEvent(e.Source)
+	if err := e.Sender.Send(ctx, cloudEvent); err != nil && e.OnError != nil {
+		e.OnError(cloudEvent, err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEndpointTransitionCloudEvent(t *testing.T) {
+	timestamp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	up := &EndpointResult{Success: true, Timestamp: timestamp.Add(-time.Minute)}
+	down := EndpointResult{Success: false, Timestamp: timestamp, Errors: []string{"timeout"}}
+	tests := []struct {
+		name     string
+		event    EndpointEvent
+		expected *TransitionData
+	}{
+		{name: "transition", event: EndpointEvent{Key: "core_api", Result: down, Previous: up, SuccessChanged: true}, expected: &TransitionData{Previous: "up", Current: "down", Errors: []string{"timeout"}}},
+		{name: "first result", event: EndpointEvent{Key: "core_api", Result: down, SuccessChanged: true}},
+		{name: "unchanged", event: EndpointEvent{Key: "core_api", Result: *up, Previous: up}},
+		{name: "error", event: EndpointEvent{Key: "core_api", Err: errors.New("boom")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := EndpointTransitionCloudEvent("", tt.event)
+			if ok != (tt.expected != nil) {
+				t.Fatalf("expected transition: %v, got %v", tt.expected != nil, ok)
+			}
+			if !ok {
+				return
+			}
+			if event.SpecVersion != "1.0" || event.Source != DefaultCloudEventSource || event.Type != CloudEventTypeEndpointTransition ||
+				event.Subject != "core_api" || !event.Time.Equal(timestamp) || event.ID != "core_api@1735732800000000000" {
+				t.Errorf("unexpected event %+v", event)
+			}
+			var data TransitionData
+			if err := json.Unmarshal(event.Data, &data); err != nil || !reflect.DeepEqual(data, *tt.expected) {
+				t.Errorf("got data %+v (%v), want %+v", data, err, *tt.expected)
+			}
+		})
+	}
+}
+
+func TestGroupTransitionCloudEvent(t *testing.T) {
+	if _, ok := GroupTransitionCloudEvent("", GroupEvent{Group: "core", State: GroupStateUp}); ok {
+		t.Error("expected the first event of a watcher not to be a transition")
+	}
+	if _, ok := GroupTransitionCloudEvent("", GroupEvent{Group: "core", Err: errors.New("boom")}); ok {
+		t.Error("expected errors not to be transitions")
+	}
+	event, ok := GroupTransitionCloudEvent("https://status.example.org", GroupEvent{Group: "core", State: GroupStatePartial, Previous: GroupStateUp, Total: 2, Failing: []string{"core_db"}})
+	if !ok {
+		t.Fatal("expected a transition")
+	}
+	if event.Source != "https://status.example.org" || event.Type != CloudEventTypeGroupTransition || event.Subject != "core" || event.ID == "" || !event.Time.IsZero() {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if expected := `{"previous":"up","current":"partial","failing":["core_db"]}`; string(event.Data) != expected {
+		t.Errorf("got data %s, want %s", event.Data, expected)
+	}
+}
+
+func TestHTTPCloudEventSender(t *testing.T) {
+	var contentType string
+	var received map[string]any
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &received)
+		w.WriteHeader(status)
+		w.Write([]byte("rejected"))
+	}))
+	defer server.Close()
+
+	event, _ := EndpointTransitionCloudEvent("test", EndpointEvent{
+		Key:            "core_api",
+		Result:         EndpointResult{Success: true, Timestamp: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+		Previous:       &EndpointResult{Success: false},
+		SuccessChanged: true,
+	})
+	sender := NewHTTPCloudEventSender(server.URL, nil)
+	if err := sender.Send(context.Background(), event); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if !strings.HasPrefix(contentType, "application/cloudevents+json") {
+		t.Errorf("unexpected content type %q", contentType)
+	}
+	expected := map[string]any{
+		"specversion":     "1.0",
+		"id":              "core_api@1735732800000000000",
+		"source":          "test",
+		"type":            CloudEventTypeEndpointTransition,
+		"subject":         "core_api",
+		"time":            "2025-01-01T12:00:00Z",
+		"datacontenttype": "application/json",
+		"data":            map[string]any{"previous": "down", "current": "up"},
+	}
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("got %v, want %v", received, expected)
+	}
+
+	status = http.StatusBadRequest
+	if err := sender.Send(context.Background(), event); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Errorf("expected an error with the status code, got %v", err)
+	}
+}
+
+func TestCloudEventEmitter(t *testing.T) {
+	up := &EndpointResult{Success: true}
+	var sent []string
+	var failed []string
+	emitter := &CloudEventEmitter{
+		Sender: CloudEventSenderFunc(func(ctx context.Context, event CloudEvent) error {
+			sent = append(sent, event.Subject+":"+string(event.Data))
+			if event.Subject == "core_db" {
+				return errors.New("unavailable")
+			}
+			return nil
+		}),
+		OnError: func(event CloudEvent, err error) {
+			failed = append(failed, event.Subject)
+		},
+	}
+	endpointEvents := make(chan EndpointEvent, 4)
+	endpointEvents <- EndpointEvent{Key: "core_api", Result: *up}
+	endpointEvents <- EndpointEvent{Key: "core_api", Result: EndpointResult{Success: false}, Previous: up, SuccessChanged: true}
+	endpointEvents <- EndpointEvent{Key: "core_db", Result: EndpointResult{Success: false}, Previous: up, SuccessChanged: true}
+	endpointEvents <- EndpointEvent{Key: "core_api", Err: errors.New("boom")}
+	close(endpointEvents)
+	emitter.EmitEndpointEvents(context.Background(), endpointEvents)
+
+	groupEvents := make(chan GroupEvent, 2)
+	groupEvents <- GroupEvent{Group: "core", State: GroupStateUp}
+	groupEvents <- GroupEvent{Group: "core", State: GroupStateDown, Previous: GroupStateUp}
+	close(groupEvents)
+	emitter.EmitGroupEvents(context.Background(), groupEvents)
+
+	expected := []string{
+		`core_api:{"previous":"up","current":"down"}`,
+		`core_db:{"previous":"up","current":"down"}`,
+		`core:{"previous":"up","current":"down"}`,
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Errorf("got %v, want %v", sent, expected)
+	}
+	if !reflect.DeepEqual(failed, []string{"core_db"}) {
+		t.Errorf("expected the failed delivery to be reported, got %v", failed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	emitter.EmitEndpointEvents(ctx, make(chan EndpointEvent))
+}
@@ -0,0 +1,213 @@
+// Command gatus provides quick terminal access to a Gatus instance.
+//
+// Usage:
+//
+//	gatus <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	status [key]                       list endpoint statuses, or the results of a single endpoint
+//	uptime <key> <duration>            show the uptime of an endpoint (duration: 1h, 24h, 7d, 30d)
+//	response-times <key> <duration>    show the response times of an endpoint
+//	push --key <key> --token <token>   push a result to an external endpoint (see 'gatus push --help')
+//
+// Every command accepts the following flags:
+//
+//	--url        base URL of the Gatus instance (defaults to $GATUS_URL)
+//	--output     output format, json or table (default table)
+//	--timeout    request timeout (defaults to gatus.DefaultTimeout)
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	gatus "github.com/TwiN/gatus-sdk"
+)
+
+const usage = `Usage: gatus <command> [flags] [arguments]
+
+Commands:
+  status [key]                       list endpoint statuses, or the results of a single endpoint
+  uptime <key> <duration>            show the uptime of an endpoint (duration: 1h, 24h, 7d, 30d)
+  response-times <key> <duration>    show the response times of an endpoint
+  push --key <key> --token <token>   push a result to an external endpoint
+
+Run 'gatus <command> --help' for the flags of a command.
+`
+
+// errUsage is returned by commands invoked with invalid arguments.
+var errUsage = errors.New("invalid usage")
+
+// runFunc executes a command with the given client and positional arguments.
+type runFunc func(ctx context.Context, client *gatus.Client, args []string, out *output) error
+
+// command is a CLI subcommand.
+type command struct {
+	// usage is the synopsis of the command.
+	usage string
+	// setup registers the flags specific to the command, if any, and returns the function executing it.
+	setup func(flags *flag.FlagSet) runFunc
+}
+
+var commands = map[string]command{
+	"status": {
+		usage: "status [key]",
+		setup: func(*flag.FlagSet) runFunc { return runStatus },
+	},
+	"uptime": {
+		usage: "uptime <key> <duration>",
+		setup: func(*flag.FlagSet) runFunc { return runUptime },
+	},
+	"response-times": {
+		usage: "response-times <key> <duration>",
+		setup: func(*flag.FlagSet) runFunc { return runResponseTimes },
+	},
+	"push": {
+		usage: "push --key <key> --token <token> [--success] [--error <message>] [--duration <duration>]",
+		setup: setupPush,
+	},
+}
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the CLI with the given arguments and returns the exit code.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(stderr, usage)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: gatus %s [flags]\n\nFlags:\n", cmd.usage)
+		flags.PrintDefaults()
+	}
+	baseURL := flags.String("url", os.Getenv("GATUS_URL"), "base URL of the Gatus instance")
+	format := flags.String("output", formatTable, "output format (json or table)")
+	timeout := flags.Duration("timeout", gatus.DefaultTimeout, "request timeout")
+	runCommand := cmd.setup(flags)
+	positional, err := parseFlags(flags, args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if *baseURL == "" {
+		fmt.Fprintln(stderr, "error: --url or $GATUS_URL must be set")
+		return 2
+	}
+	if *format != formatJSON && *format != formatTable {
+		fmt.Fprintf(stderr, "error: unsupported output format %q\n", *format)
+		return 2
+	}
+	client, err := gatus.NewClientE(*baseURL, gatus.WithTimeout(*timeout))
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 2
+	}
+	if err := runCommand(ctx, client, positional, &output{w: stdout, format: *format}); err != nil {
+		if errors.Is(err, errUsage) {
+			flags.Usage()
+			return 2
+		}
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// parseFlags parses args with flags, allowing flags to appear before, between and after positional arguments,
+// and returns the positional arguments.
+func parseFlags(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func runStatus(ctx context.Context, client *gatus.Client, args []string, out *output) error {
+	switch len(args) {
+	case 0:
+		statuses, err := client.GetAllEndpointStatuses(ctx)
+		if err != nil {
+			return err
+		}
+		return out.endpointStatuses(statuses)
+	case 1:
+		status, err := client.GetEndpointStatusByKey(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return out.endpointResults(status)
+	}
+	return errUsage
+}
+
+func runUptime(ctx context.Context, client *gatus.Client, args []string, out *output) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	data, err := client.GetEndpointUptimeData(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	return out.uptime(args[0], args[1], data)
+}
+
+func runResponseTimes(ctx context.Context, client *gatus.Client, args []string, out *output) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	data, err := client.GetEndpointResponseTimes(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	return out.responseTimes(args[0], args[1], data)
+}
+
+func setupPush(flags *flag.FlagSet) runFunc {
+	key := flags.String("key", "", "key of the external endpoint (e.g. core_worker)")
+	token := flags.String("token", os.Getenv("GATUS_TOKEN"), "bearer token of the external endpoint (defaults to $GATUS_TOKEN)")
+	success := flags.Bool("success", false, "whether the health check succeeded")
+	errorMessage := flags.String("error", "", "error message of a failed health check")
+	duration := flags.String("duration", "", "duration of the health check (e.g. 10s)")
+	return func(ctx context.Context, client *gatus.Client, args []string, out *output) error {
+		if len(args) != 0 || *key == "" || *token == "" {
+			return errUsage
+		}
+		if err := client.PushExternalEndpointResult(ctx, *key, *token, *success, *errorMessage, *duration); err != nil {
+			return err
+		}
+		return out.pushedResult(*key, *success)
+	}
+}
+
+// elapsed formats d for display, rounded to the millisecond.
+func elapsed(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
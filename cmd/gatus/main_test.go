@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	gatus "github.com/TwiN/gatus-sdk"
+	"github.com/TwiN/gatus-sdk/gatustest"
+)
+
+func newTestServer(t *testing.T) *gatustest.Server {
+	t.Helper()
+	server := gatustest.NewServer()
+	t.Cleanup(server.Close)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	server.AddEndpoint(gatustest.NewEndpointStatus("core", "api").WithSuccessfulResults(2).WithNow(now).Build())
+	server.AddEndpoint(gatustest.NewEndpointStatus("core", "db").WithFailedResult("connection refused").WithNow(now).Build())
+	server.SetUptime("core_api", "24h", 99.5)
+	server.SetResponseTimes("core_api", "1h", gatus.ResponseTimeData{Average: 150000000, Min: 100000000, Max: 300000000})
+	return server
+}
+
+func runCLI(args ...string) (code int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	code = run(context.Background(), args, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestRun_Status(t *testing.T) {
+	server := newTestServer(t)
+	code, stdout, stderr := runCLI("status", "--url", server.URL())
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "KEY") {
+		t.Fatalf("unexpected output:\n%s", stdout)
+	}
+	if !strings.Contains(lines[1], "core_api") || !strings.Contains(lines[1], "healthy") || !strings.Contains(lines[1], "2025-01-01T12:00:00Z") {
+		t.Errorf("unexpected row: %s", lines[1])
+	}
+	if !strings.Contains(lines[2], "core_db") || !strings.Contains(lines[2], "down") {
+		t.Errorf("unexpected row: %s", lines[2])
+	}
+}
+
+func TestRun_StatusByKey(t *testing.T) {
+	server := newTestServer(t)
+	code, stdout, stderr := runCLI("status", "--url", server.URL(), "--output", "json", "core_db")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	var status gatus.EndpointStatus
+	if err := json.Unmarshal([]byte(stdout), &status); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, stdout)
+	}
+	if status.Key != "core_db" || len(status.Results) != 1 {
+		t.Errorf("unexpected status: %+v", status)
+	}
+
+	code, stdout, _ = runCLI("status", "--url", server.URL(), "core_db")
+	if code != 0 || !strings.Contains(stdout, "connection refused") {
+		t.Errorf("exit code = %d, output:\n%s", code, stdout)
+	}
+}
+
+func TestRun_Uptime(t *testing.T) {
+	server := newTestServer(t)
+	code, stdout, stderr := runCLI("uptime", "--url", server.URL(), "core_api", "24h")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "99.50%") {
+		t.Errorf("unexpected output:\n%s", stdout)
+	}
+}
+
+func TestRun_ResponseTimes(t *testing.T) {
+	server := newTestServer(t)
+	code, stdout, stderr := runCLI("response-times", "--url", server.URL(), "--output", "json", "core_api", "1h")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	var data gatus.ResponseTimeData
+	if err := json.Unmarshal([]byte(stdout), &data); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, stdout)
+	}
+	if data.Average != 150000000 {
+		t.Errorf("Average = %d, want 150000000", data.Average)
+	}
+
+	// Flags may also follow positional arguments
+	code, stdout, _ = runCLI("response-times", "core_api", "1h", "--url", server.URL())
+	if code != 0 || !strings.Contains(stdout, "150ms") || !strings.Contains(stdout, "300ms") {
+		t.Errorf("exit code = %d, output:\n%s", code, stdout)
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	server := newTestServer(t)
+	tests := []struct {
+		name         string
+		args         []string
+		expectedCode int
+		expectedErr  string
+	}{
+		{name: "no command", args: nil, expectedCode: 2, expectedErr: "Usage"},
+		{name: "unknown command", args: []string{"unknown"}, expectedCode: 2, expectedErr: "unknown command"},
+		{name: "missing url", args: []string{"status", "--url", ""}, expectedCode: 2, expectedErr: "--url"},
+		{name: "invalid url", args: []string{"status", "--url", "status.example.org"}, expectedCode: 2, expectedErr: "scheme"},
+		{name: "invalid output", args: []string{"status", "--url", server.URL(), "--output", "yaml"}, expectedCode: 2, expectedErr: "yaml"},
+		{name: "missing arguments", args: []string{"uptime", "--url", server.URL(), "core_api"}, expectedCode: 2, expectedErr: "uptime <key> <duration>"},
+		{name: "invalid duration", args: []string{"uptime", "--url", server.URL(), "core_api", "2h"}, expectedCode: 1, expectedErr: "duration"},
+		{name: "not found", args: []string{"status", "--url", server.URL(), "core_missing"}, expectedCode: 1, expectedErr: "404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, stderr := runCLI(tt.args...)
+			if code != tt.expectedCode {
+				t.Errorf("exit code = %d, want %d", code, tt.expectedCode)
+			}
+			if !strings.Contains(stderr, tt.expectedErr) {
+				t.Errorf("stderr = %q, want it to contain %q", stderr, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRun_Push(t *testing.T) {
+	server := newTestServer(t)
+	server.AddExternalEndpoint("core_worker", "secret")
+
+	code, stdout, stderr := runCLI("push", "--url", server.URL(), "--key", "core_worker", "--token", "secret", "--success", "--duration", "10s")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "core_worker") {
+		t.Errorf("unexpected output:\n%s", stdout)
+	}
+	t.Setenv("GATUS_TOKEN", "secret")
+	code, _, stderr = runCLI("push", "--url", server.URL(), "--key", "core_worker", "--error", "disk full")
+	if code != 0 {
+		t.Fatalf("exit code = %d, stderr = %s", code, stderr)
+	}
+
+	pushed := server.PushedResults()
+	if len(pushed) != 2 {
+		t.Fatalf("got %d pushed results, want 2", len(pushed))
+	}
+	if !pushed[0].Success || pushed[0].Duration != "10s" {
+		t.Errorf("unexpected first result: %+v", pushed[0])
+	}
+	if pushed[1].Success || pushed[1].Error != "disk full" {
+		t.Errorf("unexpected second result: %+v", pushed[1])
+	}
+}
+
+func TestRun_PushErrors(t *testing.T) {
+	server := newTestServer(t)
+	server.AddExternalEndpoint("core_worker", "secret")
+	t.Setenv("GATUS_TOKEN", "")
+
+	if code, _, stderr := runCLI("push", "--url", server.URL(), "--key", "core_worker"); code != 2 || !strings.Contains(stderr, "--token") {
+		t.Errorf("missing token: exit code = %d, stderr = %s", code, stderr)
+	}
+	if code, _, stderr := runCLI("push", "--url", server.URL(), "--key", "core_worker", "--token", "wrong", "--success"); code != 1 || !strings.Contains(stderr, "401") {
+		t.Errorf("wrong token: exit code = %d, stderr = %s", code, stderr)
+	}
+}
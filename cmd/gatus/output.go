@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	gatus "github.com/TwiN/gatus-sdk"
+)
+
+const (
+	formatJSON  = "json"
+	formatTable = "table"
+)
+
+// output writes command results in the selected format.
+type output struct {
+	w      io.Writer
+	format string
+}
+
+// json writes v as indented JSON.
+func (o *output) json(v any) error {
+	encoder := json.NewEncoder(o.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// table writes the header and rows aligned in columns.
+func (o *output) table(header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(o.w, 0, 0, 2, ' ', 0)
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			if i > 0 {
+				fmt.Fprint(tw, "\t")
+			}
+			fmt.Fprint(tw, cell)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+func (o *output) endpointStatuses(statuses []gatus.EndpointStatus) error {
+	if o.format == formatJSON {
+		return o.json(statuses)
+	}
+	rows := make([][]string, 0, len(statuses))
+	for _, status := range statuses {
+		lastCheck := "-"
+		if last := status.LastResult(); last != nil {
+			lastCheck = last.Timestamp.Format(time.RFC3339)
+		}
+		rows = append(rows, []string{status.Key, status.Group, status.Name, status.Severity().String(), lastCheck})
+	}
+	return o.table([]string{"KEY", "GROUP", "NAME", "STATUS", "LAST CHECK"}, rows)
+}
+
+func (o *output) endpointResults(status *gatus.EndpointStatus) error {
+	if o.format == formatJSON {
+		return o.json(status)
+	}
+	rows := make([][]string, 0, len(status.Results))
+	for _, result := range status.Results {
+		rows = append(rows, []string{
+			result.Timestamp.Format(time.RFC3339),
+			result.Severity().String(),
+			fmt.Sprint(result.Status),
+			elapsed(result.Elapsed),
+			strings.Join(result.Errors, "; "),
+		})
+	}
+	return o.table([]string{"TIMESTAMP", "STATUS", "CODE", "DURATION", "ERRORS"}, rows)
+}
+
+func (o *output) uptime(key, duration string, data *gatus.UptimeData) error {
+	if o.format == formatJSON {
+		return o.json(data)
+	}
+	return o.table([]string{"KEY", "DURATION", "UPTIME"}, [][]string{
+		{key, duration, fmt.Sprintf("%.2f%%", data.Uptime)},
+	})
+}
+
+func (o *output) responseTimes(key, duration string, data *gatus.ResponseTimeData) error {
+	if o.format == formatJSON {
+		return o.json(data)
+	}
+	return o.table([]string{"KEY", "DURATION", "AVERAGE", "MIN", "MAX"}, [][]string{
+		{key, duration, elapsed(data.AverageDuration), elapsed(data.MinDuration), elapsed(data.MaxDuration)},
+	})
+}
+
+func (o *output) pushedResult(key string, success bool) error {
+	if o.format == formatJSON {
+		return o.json(map[string]any{"key": key, "success": success})
+	}
+	return o.table([]string{"KEY", "SUCCESS"}, [][]string{{key, fmt.Sprint(success)}})
+}
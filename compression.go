@@ -0,0 +1,76 @@
+package gatussdk
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Decompressor creates a reader decompressing the content read from r.
+type Decompressor func(r io.Reader) (io.ReadCloser, error)
+
+// WithDecompressor registers a decompressor for the given content encoding (e.g. "br" or "zstd").
+// Registered encodings are advertised to the server through the Accept-Encoding header, after gzip,
+// which is always supported. Registering a decompressor for an already registered encoding replaces it.
+//
+// The SDK has no dependencies outside the standard library, so decoders for encodings the standard library
+// doesn't support must be provided by the caller.
+//
+// Example:
+//
+//	import (
+//	    "github.com/andybalholm/brotli"
+//	    "github.com/klauspost/compress/zstd"
+//	)
+//
+//	client := NewClient("https://status.example.org",
+//	    WithDecompressor("br", func(r io.Reader) (io.ReadCloser, error) {
+//	        return io.NopCloser(brotli.NewReader(r)), nil
+//	    }),
+//	    WithDecompressor("zstd", func(r io.Reader) (io.ReadCloser, error) {
+//	        decoder, err := zstd.NewReader(r)
+//	        if err != nil {
+//	            return nil, err
+//	        }
+//	        return decoder.IOReadCloser(), nil
+//	    }),
+//	)
+func WithDecompressor(encoding string, decompressor Decompressor) ClientOption {
+	return func(c *Client) {
+		encoding = strings.ToLower(strings.TrimSpace(encoding))
+		if _, exists := c.decompressors[encoding]; !exists {
+			c.encodings = append(c.encodings, encoding)
+		}
+		c.decompressors[encoding] = decompressor
+	}
+}
+
+// defaultDecompressors returns the decompressors supported by every client.
+func defaultDecompressors() map[string]Decompressor {
+	return map[string]Decompressor{
+		"gzip": func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+	}
+}
+
+// acceptEncoding returns the value of the Accept-Encoding header advertising the supported encodings.
+func (c *Client) acceptEncoding() string {
+	return strings.Join(c.encodings, ", ")
+}
+
+// decompress wraps body with a decompressor for the given content encoding.
+// If the encoding is empty or unknown, body is returned as is.
+func (c *Client) decompress(body io.Reader, encoding string) (io.ReadCloser, error) {
+	encoding = strings.ToLower(strings.TrimSpace(encoding))
+	decompressor, ok := c.decompressors[encoding]
+	if !ok {
+		return io.NopCloser(body), nil
+	}
+	reader, err := decompressor(body)
+	if err != nil {
+		return nil, fmt.Errorf("creating %s reader: %w", encoding, err)
+	}
+	return reader, nil
+}
@@ -0,0 +1,61 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// base64Decompressor is a stand-in for a third-party decoder such as brotli or zstd.
+func base64Decompressor(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(base64.NewDecoder(base64.StdEncoding, r)), nil
+}
+
+func TestWithDecompressor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip, b64" {
+			t.Errorf("Accept-Encoding = %v, want gzip, b64", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "B64")
+		w.Write([]byte(base64.StdEncoding.EncodeToString([]byte(`[{"name":"api","key":"core_api"}]`))))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithDecompressor("b64", base64Decompressor), WithDecompressor("b64", base64Decompressor))
+	statuses, err := client.GetAllEndpointStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Key != "core_api" {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+}
+
+func TestClient_decompress(t *testing.T) {
+	client := NewClient("https://example.com")
+	if client.acceptEncoding() != "gzip" {
+		t.Errorf("acceptEncoding() = %v, want gzip", client.acceptEncoding())
+	}
+
+	t.Run("unknown encoding is passed through", func(t *testing.T) {
+		reader, err := client.decompress(strings.NewReader("plain"), "identity")
+		if err != nil {
+			t.Fatalf("decompress() error = %v", err)
+		}
+		body, _ := io.ReadAll(reader)
+		if string(body) != "plain" {
+			t.Errorf("body = %v, want plain", string(body))
+		}
+	})
+
+	t.Run("invalid gzip", func(t *testing.T) {
+		_, err := client.decompress(strings.NewReader("not gzip"), "gzip")
+		if err == nil || !strings.Contains(err.Error(), "creating gzip reader") {
+			t.Errorf("expected gzip reader error, got %v", err)
+		}
+	})
+}
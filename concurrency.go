@@ -0,0 +1,41 @@
+package gatussdk
+
+import (
+	"context"
+	"sync"
+)
+
+// WithMaxConcurrency limits the number of requests the client has in flight at once to n, across every method
+// and all the parallelism initiated by the SDK, such as batch methods, paginated retrieval and watchers.
+// Requests beyond the limit wait for a slot, or for their context to be done. A request occupies its slot until
+// its response has been read. If n is zero or negative, the number of requests in flight is not limited.
+//
+// Unlike WithBatchConcurrency, which applies to each batch call separately, the limit is shared by all calls,
+// so that the SDK cannot open hundreds of connections to a Gatus instance.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithMaxConcurrency(8))
+func WithMaxConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		if n > 0 {
+			c.limiter = make(chan struct{}, n)
+		} else {
+			c.limiter = nil
+		}
+	}
+}
+
+// acquire waits until fewer than the maximum number of requests are in flight, and returns a function
+// releasing the acquired slot. The returned function may be called more than once.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.limiter == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.limiter <- struct{}{}:
+		return sync.OnceFunc(func() { <-c.limiter }), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
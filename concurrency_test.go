@@ -0,0 +1,70 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithMaxConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithMaxConcurrency(2), WithBatchConcurrency(10))
+	keys := make([]string, 10)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("core_endpoint-%d", i)
+	}
+	// Batch calls running concurrently share the same limit
+	done := make(chan error)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := client.GetEndpointStatusesByKeys(context.Background(), keys, 0)
+			done <- err
+		}()
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Errorf("GetEndpointStatusesByKeys() error = %v", err)
+		}
+	}
+	if maxInFlight.Load() != 2 {
+		t.Errorf("got at most %d requests in flight, want 2", maxInFlight.Load())
+	}
+}
+
+func TestWithMaxConcurrency_ContextDone(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(server.URL, WithMaxConcurrency(1))
+	go client.GetAllEndpointStatuses(context.Background())
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetAllEndpointStatuses(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded while waiting for a slot, got %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package gatussdk
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidCondition is returned when a condition expression cannot be parsed.
+var ErrInvalidCondition = errors.New("invalid condition")
+
+// Operator is a comparison operator used in condition expressions.
+type Operator string
+
+const (
+	// OperatorEqual checks that both operands are equal.
+	OperatorEqual Operator = "=="
+	// OperatorNotEqual checks that the operands differ.
+	OperatorNotEqual Operator = "!="
+	// OperatorLessThanOrEqual checks that the left operand is at most the right operand.
+	OperatorLessThanOrEqual Operator = "<="
+	// OperatorGreaterThanOrEqual checks that the left operand is at least the right operand.
+	OperatorGreaterThanOrEqual Operator = ">="
+	// OperatorGreaterThan checks that the left operand is greater than the right operand.
+	OperatorGreaterThan Operator = ">"
+	// OperatorLessThan checks that the left operand is less than the right operand.
+	OperatorLessThan Operator = "<"
+)
+
+// operators lists the operators in the order Gatus looks for them in a condition.
+var operators = []Operator{
+	OperatorEqual,
+	OperatorNotEqual,
+	OperatorLessThanOrEqual,
+	OperatorGreaterThanOrEqual,
+	OperatorGreaterThan,
+	OperatorLessThan,
+}
+
+// placeholderPattern matches a placeholder such as [STATUS] or [BODY].
+var placeholderPattern = regexp.MustCompile(`\[[A-Z_]+\]`)
+
+// Operand is one side of a condition expression.
+type Operand struct {
+	// Expression is the operand as written in the configuration (e.g. "[BODY].user.id", "len([BODY].data)" or "200").
+	Expression string `json:"expression"`
+	// Function is the name of the function wrapping the operand (e.g. "len", "has", "pat" or "any"), if any.
+	Function string `json:"function,omitempty"`
+	// Placeholder is the placeholder referenced by the operand (e.g. "[BODY]"), if any.
+	// Operands without a placeholder are literal expected values.
+	Placeholder string `json:"placeholder,omitempty"`
+	// Resolved is the value the operand resolved to. Gatus only displays resolved values in the condition
+	// results of some checks (typically failed ones); see HasResolved.
+	Resolved string `json:"resolved,omitempty"`
+	// HasResolved reports whether the resolved value was displayed.
+	HasResolved bool `json:"hasResolved,omitempty"`
+}
+
+// IsPlaceholder reports whether the operand references a placeholder rather than being a literal value.
+func (o Operand) IsPlaceholder() bool {
+	return o.Placeholder != ""
+}
+
+// Value returns the resolved value of the operand if it was displayed, and its expression otherwise.
+func (o Operand) Value() string {
+	if o.HasResolved {
+		return o.Resolved
+	}
+	return o.Expression
+}
+
+// Condition is the structured form of a condition expression.
+type Condition struct {
+	// Left is the left-hand side of the condition, typically a placeholder.
+	Left Operand `json:"left"`
+	// Operator is the comparison operator.
+	Operator Operator `json:"operator"`
+	// Right is the right-hand side of the condition, typically the expected value.
+	Right Operand `json:"right"`
+}
+
+// String returns the condition as written in the configuration, without resolved values.
+func (c *Condition) String() string {
+	return fmt.Sprintf("%s %s %s", c.Left.Expression, c.Operator, c.Right.Expression)
+}
+
+// ParseCondition parses a condition expression such as "[STATUS] == 200" or "[BODY].id == [CONTEXT].user-id".
+// Resolved values, which Gatus displays in parentheses after an operand in condition results
+// (e.g. "[STATUS] (500) == 200"), are extracted into the Resolved field of the operand.
+// Errors wrap ErrInvalidCondition.
+//
+// Example:
+//
+//	for _, conditionResult := range result.ConditionResults {
+//	    if conditionResult.Success {
+//	        continue
+//	    }
+//	    condition, err := ParseCondition(conditionResult.Condition)
+//	    if err != nil {
+//	        continue
+//	    }
+//	    fmt.Printf("%s was %s, expected %s %s\n", condition.Left.Expression, condition.Left.Value(), condition.Operator, condition.Right.Value())
+//	}
+func ParseCondition(expression string) (*Condition, error) {
+	for _, operator := range operators {
+		left, right, found := strings.Cut(expression, " "+string(operator)+" ")
+		if !found {
+			continue
+		}
+		condition := &Condition{
+			Left:     parseOperand(left),
+			Operator: operator,
+			Right:    parseOperand(right),
+		}
+		if condition.Left.Expression == "" || condition.Right.Expression == "" {
+			return nil, fmt.Errorf("%w: missing operand in %q", ErrInvalidCondition, expression)
+		}
+		return condition, nil
+	}
+	return nil, fmt.Errorf("%w: no operator in %q", ErrInvalidCondition, expression)
+}
+
+// Parse parses the condition expression of the result (see ParseCondition).
+func (r *ConditionResult) Parse() (*Condition, error) {
+	return ParseCondition(r.Condition)
+}
+
+// parseOperand parses one side of a condition, splitting off the resolved value if one is displayed.
+func parseOperand(s string) Operand {
+	s = strings.TrimSpace(s)
+	operand := Operand{Expression: s}
+	// A resolved value is a parenthesized suffix separated from the expression by a space,
+	// unlike the parentheses of a function call such as len([BODY].data)
+	if start := openingParenthesis(s); start > 1 && s[start-1] == ' ' {
+		operand.Expression = strings.TrimSpace(s[:start])
+		operand.Resolved = s[start+1 : len(s)-1]
+		operand.HasResolved = true
+	}
+	if name, _, found := strings.Cut(operand.Expression, "("); found && strings.HasSuffix(operand.Expression, ")") && isFunctionName(name) {
+		operand.Function = name
+	}
+	operand.Placeholder = placeholderPattern.FindString(operand.Expression)
+	return operand
+}
+
+// openingParenthesis returns the index of the parenthesis opening the one closing s, or -1 if s does not end
+// with a balanced parenthesized group.
+func openingParenthesis(s string) int {
+	if !strings.HasSuffix(s, ")") {
+		return -1
+	}
+	depth := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		switch s[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isFunctionName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
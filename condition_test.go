@@ -0,0 +1,122 @@
+package gatussdk
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		expression    string
+		expected      *Condition
+		expectedError bool
+	}{
+		{
+			expression: "[STATUS] == 200",
+			expected: &Condition{
+				Left:     Operand{Expression: "[STATUS]", Placeholder: "[STATUS]"},
+				Operator: OperatorEqual,
+				Right:    Operand{Expression: "200"},
+			},
+		},
+		{
+			expression: "[STATUS] (500) == 200",
+			expected: &Condition{
+				Left:     Operand{Expression: "[STATUS]", Placeholder: "[STATUS]", Resolved: "500", HasResolved: true},
+				Operator: OperatorEqual,
+				Right:    Operand{Expression: "200"},
+			},
+		},
+		{
+			expression: "[BODY].id (1) == [CONTEXT].user-id (2)",
+			expected: &Condition{
+				Left:     Operand{Expression: "[BODY].id", Placeholder: "[BODY]", Resolved: "1", HasResolved: true},
+				Operator: OperatorEqual,
+				Right:    Operand{Expression: "[CONTEXT].user-id", Placeholder: "[CONTEXT]", Resolved: "2", HasResolved: true},
+			},
+		},
+		{
+			expression: "[RESPONSE_TIME] <= 500",
+			expected: &Condition{
+				Left:     Operand{Expression: "[RESPONSE_TIME]", Placeholder: "[RESPONSE_TIME]"},
+				Operator: OperatorLessThanOrEqual,
+				Right:    Operand{Expression: "500"},
+			},
+		},
+		{
+			expression: "[CERTIFICATE_EXPIRATION] (172800000) > 48h",
+			expected: &Condition{
+				Left:     Operand{Expression: "[CERTIFICATE_EXPIRATION]", Placeholder: "[CERTIFICATE_EXPIRATION]", Resolved: "172800000", HasResolved: true},
+				Operator: OperatorGreaterThan,
+				Right:    Operand{Expression: "48h"},
+			},
+		},
+		{
+			expression: "len([BODY].data) (0) >= 1",
+			expected: &Condition{
+				Left:     Operand{Expression: "len([BODY].data)", Function: "len", Placeholder: "[BODY]", Resolved: "0", HasResolved: true},
+				Operator: OperatorGreaterThanOrEqual,
+				Right:    Operand{Expression: "1"},
+			},
+		},
+		{
+			expression: "has([BODY].errors) == false",
+			expected: &Condition{
+				Left:     Operand{Expression: "has([BODY].errors)", Function: "has", Placeholder: "[BODY]"},
+				Operator: OperatorEqual,
+				Right:    Operand{Expression: "false"},
+			},
+		},
+		{
+			expression: "[BODY] (Service down (maintenance)) != pat(*down*)",
+			expected: &Condition{
+				Left:     Operand{Expression: "[BODY]", Placeholder: "[BODY]", Resolved: "Service down (maintenance)", HasResolved: true},
+				Operator: OperatorNotEqual,
+				Right:    Operand{Expression: "pat(*down*)", Function: "pat"},
+			},
+		},
+		{
+			expression: "[CONNECTED] < 1",
+			expected: &Condition{
+				Left:     Operand{Expression: "[CONNECTED]", Placeholder: "[CONNECTED]"},
+				Operator: OperatorLessThan,
+				Right:    Operand{Expression: "1"},
+			},
+		},
+		{expression: "[CONNECTED]", expectedError: true},
+		{expression: "[STATUS]==200", expectedError: true},
+		{expression: " == 200", expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			condition, err := ParseCondition(tt.expression)
+			if tt.expectedError {
+				if !errors.Is(err, ErrInvalidCondition) {
+					t.Errorf("expected ErrInvalidCondition, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCondition() error = %v", err)
+			}
+			if !reflect.DeepEqual(condition, tt.expected) {
+				t.Errorf("got %+v, want %+v", condition, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCondition_String(t *testing.T) {
+	conditionResult := ConditionResult{Condition: "[BODY].id (1) == [CONTEXT].user-id (2)"}
+	condition, err := conditionResult.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if condition.String() != "[BODY].id == [CONTEXT].user-id" {
+		t.Errorf("String() = %v", condition.String())
+	}
+	if condition.Left.Value() != "1" || condition.Right.Value() != "2" || !condition.Right.IsPlaceholder() {
+		t.Errorf("unexpected operands: %+v", condition)
+	}
+}
@@ -0,0 +1,203 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Config configures a Client as an alternative to functional options, making it easy to load SDK settings
+// from application configuration files. Zero-valued fields leave the corresponding defaults in place.
+//
+// Durations can be written as Go duration strings (e.g. "10s") when decoding from JSON,
+// and are decoded natively by most YAML libraries.
+type Config struct {
+	// BaseURL is the base URL of the Gatus instance. It is required.
+	BaseURL string `json:"baseURL" yaml:"baseURL"`
+	// BasePath is the path under which Gatus is served, if any (see WithBasePath).
+	BasePath string `json:"basePath,omitempty" yaml:"basePath,omitempty"`
+	// Timeout is the client timeout (see WithTimeout).
+	Timeout time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// RequestTimeout is the timeout applied to every request through its context (see WithDefaultRequestTimeout).
+	RequestTimeout time.Duration `json:"requestTimeout,omitempty" yaml:"requestTimeout,omitempty"`
+	// UserAgent is the User-Agent header sent with every request (see WithUserAgent).
+	UserAgent string `json:"userAgent,omitempty" yaml:"userAgent,omitempty"`
+	// Auth configures the authentication of requests.
+	Auth AuthConfig `json:"auth,omitempty" yaml:"auth,omitempty"`
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept per host (see WithMaxIdleConnsPerHost).
+	MaxIdleConnsPerHost int `json:"maxIdleConnsPerHost,omitempty" yaml:"maxIdleConnsPerHost,omitempty"`
+	// IdleConnTimeout is how long idle connections are kept (see WithIdleConnTimeout).
+	IdleConnTimeout time.Duration `json:"idleConnTimeout,omitempty" yaml:"idleConnTimeout,omitempty"`
+	// DialTimeout is the maximum amount of time to wait for a connection (see WithDialTimeout).
+	DialTimeout time.Duration `json:"dialTimeout,omitempty" yaml:"dialTimeout,omitempty"`
+	// TLSHandshakeTimeout is the maximum amount of time to wait for the TLS handshake (see WithTLSHandshakeTimeout).
+	TLSHandshakeTimeout time.Duration `json:"tlsHandshakeTimeout,omitempty" yaml:"tlsHandshakeTimeout,omitempty"`
+	// ResponseHeaderTimeout is the maximum amount of time to wait for response headers (see WithResponseHeaderTimeout).
+	ResponseHeaderTimeout time.Duration `json:"responseHeaderTimeout,omitempty" yaml:"responseHeaderTimeout,omitempty"`
+	// DisableHTTP2 restricts the client to HTTP/1.1 (see WithHTTP2).
+	DisableHTTP2 bool `json:"disableHTTP2,omitempty" yaml:"disableHTTP2,omitempty"`
+	// DisableKeepAlives disables connection reuse (see WithKeepAlives).
+	DisableKeepAlives bool `json:"disableKeepAlives,omitempty" yaml:"disableKeepAlives,omitempty"`
+	// InsecureSkipTLSVerify disables the verification of the certificate of Gatus, for development only
+	// (see WithInsecureSkipTLSVerify).
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" yaml:"insecureSkipTLSVerify,omitempty"`
+	// MaxResponseSize is the maximum size of response bodies in bytes (see WithMaxResponseSize).
+	MaxResponseSize int64 `json:"maxResponseSize,omitempty" yaml:"maxResponseSize,omitempty"`
+	// BatchConcurrency is the maximum number of concurrent requests made by batch methods (see WithBatchConcurrency).
+	BatchConcurrency int `json:"batchConcurrency,omitempty" yaml:"batchConcurrency,omitempty"`
+	// Retry configures how failed requests are retried (see WithRetry). Requests are not retried if MaxRetries is zero.
+	Retry RetryPolicy `json:"retry,omitempty" yaml:"retry,omitempty"`
+	// MaxConcurrency is the maximum number of requests in flight at once (see WithMaxConcurrency).
+	MaxConcurrency int `json:"maxConcurrency,omitempty" yaml:"maxConcurrency,omitempty"`
+	// RequestID enables the generation of X-Request-ID headers (see WithRequestID).
+	RequestID bool `json:"requestID,omitempty" yaml:"requestID,omitempty"`
+	// Singleflight enables the deduplication of identical concurrent GET requests (see WithSingleflight).
+	Singleflight bool `json:"singleflight,omitempty" yaml:"singleflight,omitempty"`
+	// ConditionalRequests enables conditional GET requests (see WithConditionalRequests).
+	ConditionalRequests bool `json:"conditionalRequests,omitempty" yaml:"conditionalRequests,omitempty"`
+}
+
+// AuthConfig configures the authentication of requests.
+type AuthConfig struct {
+	// BearerToken is sent in the Authorization header of every request (see WithBearerToken).
+	BearerToken string `json:"bearerToken,omitempty" yaml:"bearerToken,omitempty"`
+}
+
+// UnmarshalJSON decodes a Config, accepting durations either as Go duration strings (e.g. "10s")
+// or as a number of nanoseconds.
+func (c *Config) UnmarshalJSON(data []byte) error {
+	type alias Config
+	aux := struct {
+		*alias
+		Timeout               any `json:"timeout"`
+		RequestTimeout        any `json:"requestTimeout"`
+		IdleConnTimeout       any `json:"idleConnTimeout"`
+		DialTimeout           any `json:"dialTimeout"`
+		TLSHandshakeTimeout   any `json:"tlsHandshakeTimeout"`
+		ResponseHeaderTimeout any `json:"responseHeaderTimeout"`
+	}{alias: (*alias)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if c.Timeout, err = parseConfigDuration("timeout", aux.Timeout, c.Timeout); err != nil {
+		return err
+	}
+	if c.RequestTimeout, err = parseConfigDuration("requestTimeout", aux.RequestTimeout, c.RequestTimeout); err != nil {
+		return err
+	}
+	if c.IdleConnTimeout, err = parseConfigDuration("idleConnTimeout", aux.IdleConnTimeout, c.IdleConnTimeout); err != nil {
+		return err
+	}
+	if c.DialTimeout, err = parseConfigDuration("dialTimeout", aux.DialTimeout, c.DialTimeout); err != nil {
+		return err
+	}
+	if c.TLSHandshakeTimeout, err = parseConfigDuration("tlsHandshakeTimeout", aux.TLSHandshakeTimeout, c.TLSHandshakeTimeout); err != nil {
+		return err
+	}
+	if c.ResponseHeaderTimeout, err = parseConfigDuration("responseHeaderTimeout", aux.ResponseHeaderTimeout, c.ResponseHeaderTimeout); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseConfigDuration converts a decoded JSON value into a duration, returning current if the value is absent.
+func parseConfigDuration(field string, value any, current time.Duration) (time.Duration, error) {
+	switch v := value.(type) {
+	case nil:
+		return current, nil
+	case float64:
+		return time.Duration(v), nil
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("decoding %s: %w", field, err)
+		}
+		return duration, nil
+	}
+	return 0, fmt.Errorf("decoding %s: unsupported value %v", field, value)
+}
+
+// options returns the client options corresponding to the configuration.
+func (c Config) options() []ClientOption {
+	var opts []ClientOption
+	if c.BasePath != "" {
+		opts = append(opts, WithBasePath(c.BasePath))
+	}
+	if c.Timeout > 0 {
+		opts = append(opts, WithTimeout(c.Timeout))
+	}
+	if c.RequestTimeout > 0 {
+		opts = append(opts, WithDefaultRequestTimeout(c.RequestTimeout))
+	}
+	if c.UserAgent != "" {
+		opts = append(opts, WithUserAgent(c.UserAgent))
+	}
+	if c.Auth.BearerToken != "" {
+		opts = append(opts, WithBearerToken(c.Auth.BearerToken))
+	}
+	if c.MaxIdleConnsPerHost > 0 {
+		opts = append(opts, WithMaxIdleConnsPerHost(c.MaxIdleConnsPerHost))
+	}
+	if c.IdleConnTimeout > 0 {
+		opts = append(opts, WithIdleConnTimeout(c.IdleConnTimeout))
+	}
+	if c.DialTimeout > 0 {
+		opts = append(opts, WithDialTimeout(c.DialTimeout))
+	}
+	if c.TLSHandshakeTimeout > 0 {
+		opts = append(opts, WithTLSHandshakeTimeout(c.TLSHandshakeTimeout))
+	}
+	if c.ResponseHeaderTimeout > 0 {
+		opts = append(opts, WithResponseHeaderTimeout(c.ResponseHeaderTimeout))
+	}
+	if c.DisableHTTP2 {
+		opts = append(opts, WithHTTP2(false))
+	}
+	if c.DisableKeepAlives {
+		opts = append(opts, WithKeepAlives(false))
+	}
+	if c.InsecureSkipTLSVerify {
+		opts = append(opts, WithInsecureSkipTLSVerify())
+	}
+	if c.MaxResponseSize > 0 {
+		opts = append(opts, WithMaxResponseSize(c.MaxResponseSize))
+	}
+	if c.BatchConcurrency > 0 {
+		opts = append(opts, WithBatchConcurrency(c.BatchConcurrency))
+	}
+	if c.MaxConcurrency > 0 {
+		opts = append(opts, WithMaxConcurrency(c.MaxConcurrency))
+	}
+	if c.RequestID {
+		opts = append(opts, WithRequestID(true))
+	}
+	if c.Singleflight {
+		opts = append(opts, WithSingleflight(true))
+	}
+	if c.ConditionalRequests {
+		opts = append(opts, WithConditionalRequests(true))
+	}
+	if c.Retry.MaxRetries > 0 {
+		opts = append(opts, WithRetry(c.Retry))
+	}
+	return opts
+}
+
+// NewClientWithConfig creates a new Gatus API client from a configuration struct.
+// The given options are applied after the configuration, so they take precedence.
+// A *ValidationError is returned if the configuration is invalid.
+//
+// Example:
+//
+//	var config gatus.Config
+//	if err := json.Unmarshal(data, &config); err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := gatus.NewClientWithConfig(config)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewClientWithConfig(config Config, opts ...ClientOption) (*Client, error) {
+	return NewClientE(config.BaseURL, append(config.options(), opts...)...)
+}
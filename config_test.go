@@ -0,0 +1,85 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestConfig_UnmarshalJSON(t *testing.T) {
+	data := `{
+		"baseURL": "https://status.example.org",
+		"timeout": "15s",
+		"requestTimeout": "2s",
+		"idleConnTimeout": 30000000000,
+		"dialTimeout": "5s",
+		"responseHeaderTimeout": "10s",
+		"userAgent": "MyApp/1.0",
+		"auth": {"bearerToken": "secret"},
+		"maxResponseSize": 1048576,
+		"disableHTTP2": true,
+		"retry": {"maxRetries": 2, "initialBackoff": "100ms", "maxBackoff": 2000000000}
+	}`
+	var config Config
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	expected := Config{
+		BaseURL:               "https://status.example.org",
+		Timeout:               15 * time.Second,
+		RequestTimeout:        2 * time.Second,
+		IdleConnTimeout:       30 * time.Second,
+		DialTimeout:           5 * time.Second,
+		UserAgent:             "MyApp/1.0",
+		Auth:                  AuthConfig{BearerToken: "secret"},
+		MaxResponseSize:       1 << 20,
+		DisableHTTP2:          true,
+		ResponseHeaderTimeout: 10 * time.Second,
+		Retry:                 RetryPolicy{MaxRetries: 2, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second},
+	}
+	if config != expected {
+		t.Errorf("Unmarshal() = %+v, want %+v", config, expected)
+	}
+	for _, invalid := range []string{`{"timeout":"soon"}`, `{"timeout":true}`, `{"retry":{"maxBackoff":"later"}}`, `{"tlsHandshakeTimeout":"fast"}`} {
+		if err := json.Unmarshal([]byte(invalid), &config); err == nil {
+			t.Errorf("expected error for %s", invalid)
+		}
+	}
+}
+
+func TestNewClientWithConfig(t *testing.T) {
+	client, err := NewClientWithConfig(Config{
+		BaseURL:               "https://status.example.org/",
+		Timeout:               15 * time.Second,
+		UserAgent:             "MyApp/1.0",
+		Auth:                  AuthConfig{BearerToken: "secret"},
+		MaxIdleConnsPerHost:   5,
+		DisableKeepAlives:     true,
+		InsecureSkipTLSVerify: true,
+		BatchConcurrency:      3,
+		RequestID:             true,
+	}, WithUserAgent("Override/1.0"))
+	if err != nil {
+		t.Fatalf("NewClientWithConfig() error = %v", err)
+	}
+	if client.baseURL != "https://status.example.org" || client.httpClient.Timeout != 15*time.Second {
+		t.Errorf("unexpected client: %+v", client)
+	}
+	if client.userAgent != "Override/1.0" {
+		t.Errorf("userAgent = %v, want options to take precedence", client.userAgent)
+	}
+	if client.token != "secret" || client.batchConcurrency != 3 || !client.generateRequestIDs {
+		t.Errorf("unexpected client: %+v", client)
+	}
+	transport := client.httpClient.Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != 5 || !transport.DisableKeepAlives || !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("unexpected transport settings: %+v", transport)
+	}
+
+	if _, err := NewClientWithConfig(Config{}); err == nil {
+		t.Error("expected error for missing base URL")
+	} else if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("expected ValidationError, got %v", err)
+	}
+}
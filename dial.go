@@ -0,0 +1,162 @@
+package gatussdk
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultDialTimeout is the default maximum amount of time to wait for a connection to be established.
+	DefaultDialTimeout = 30 * time.Second
+	// DefaultDialKeepAlive is the default interval between TCP keep-alive probes.
+	DefaultDialKeepAlive = 30 * time.Second
+)
+
+// WithHostOverride makes the client connect to address whenever it connects to host, for split-horizon DNS
+// setups in which the hostname of Gatus resolves to an address that is unreachable from where the client runs.
+// The address can be an IP address or another hostname, optionally with a port replacing the one of the request.
+// Requests are still sent for host, so the TLS certificate is verified against host and the Host header is unchanged.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.com", WithHostOverride("status.example.com", "10.0.0.5"))
+func WithHostOverride(host, address string) ClientOption {
+	return func(c *Client) {
+		if !c.installDialContext() {
+			return
+		}
+		if c.hostOverrides == nil {
+			c.hostOverrides = make(map[string]string)
+		}
+		c.hostOverrides[host] = address
+	}
+}
+
+// WithResolver sets the resolver used to look up hostnames, e.g. to query a specific DNS server.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	resolver := &net.Resolver{
+//	    PreferGo: true,
+//	    Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+//	        return (&net.Dialer{}).DialContext(ctx, network, "10.0.0.53:53")
+//	    },
+//	}
+//	client := NewClient("https://status.example.com", WithResolver(resolver))
+func WithResolver(resolver *net.Resolver) ClientOption {
+	return func(c *Client) {
+		if dialer := c.dialer(); dialer != nil {
+			dialer.Resolver = resolver
+		}
+	}
+}
+
+// WithDialTimeout sets the maximum amount of time to wait for a connection to be established, including
+// name resolution. Defaults to DefaultDialTimeout. Connections are then established by the client, with a
+// keep-alive interval of DefaultDialKeepAlive, rather than by the DialContext of the transport, if any.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithDialTimeout(5*time.Second))
+func WithDialTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		if dialer := c.dialer(); dialer != nil {
+			dialer.Timeout = timeout
+		}
+	}
+}
+
+// WithTLSHandshakeTimeout sets the maximum amount of time to wait for the TLS handshake to complete.
+// A zero timeout means no timeout.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithTLSHandshakeTimeout(5*time.Second))
+func WithTLSHandshakeTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.TLSHandshakeTimeout = timeout
+		}
+	}
+}
+
+// WithResponseHeaderTimeout sets the maximum amount of time to wait for the response headers once the request
+// is sent. Unlike WithTimeout, it does not limit the time spent reading the response body, which makes it
+// suitable to detect unresponsive instances without cutting off large responses.
+// A zero timeout means no timeout.
+// This option has no effect if the transport is not an *http.Transport.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithResponseHeaderTimeout(10*time.Second))
+func WithResponseHeaderTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			transport.ResponseHeaderTimeout = timeout
+		}
+	}
+}
+
+// dialer returns the dialer used by the transport, installing it if needed (see installDialContext),
+// or nil if the transport is not an *http.Transport. Once the dialer is returned, it replaces the dialing of the
+// transport, so that its settings take effect even if the transport already had a DialContext.
+func (c *Client) dialer() *net.Dialer {
+	if !c.installDialContext() {
+		return nil
+	}
+	if c.netDialer == nil {
+		c.netDialer = &net.Dialer{Timeout: DefaultDialTimeout, KeepAlive: DefaultDialKeepAlive}
+	}
+	return c.netDialer
+}
+
+// installDialContext makes the transport dial through dialContext, keeping its own DialContext, if any, to
+// delegate to. It returns false if the transport is not an *http.Transport.
+func (c *Client) installDialContext() bool {
+	transport := c.transport()
+	if transport == nil {
+		return false
+	}
+	if transport != c.dialTransport {
+		c.transportDial = transport.DialContext
+		c.dialTransport = transport
+		transport.DialContext = c.dialContext
+		preserveHTTP2(transport)
+	}
+	return true
+}
+
+// dialContext connects to addr, applying host overrides. It dials with the dialer of the client if it was
+// configured, with the original DialContext of the transport otherwise.
+func (c *Client) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if host, port, err := net.SplitHostPort(addr); err == nil {
+		if override, ok := c.hostOverrides[host]; ok {
+			if _, _, err := net.SplitHostPort(override); err == nil {
+				addr = override
+			} else {
+				addr = net.JoinHostPort(override, port)
+			}
+		}
+	}
+	if c.netDialer != nil {
+		return c.netDialer.DialContext(ctx, network, addr)
+	}
+	if c.transportDial != nil {
+		return c.transportDial(ctx, network, addr)
+	}
+	return (&net.Dialer{Timeout: DefaultDialTimeout, KeepAlive: DefaultDialKeepAlive}).DialContext(ctx, network, addr)
+}
+
+// preserveHTTP2 keeps HTTP/2 enabled on a transport whose TLS or dial settings were customized, which would
+// otherwise disable it, unless the protocols were explicitly configured (see WithHTTP2).
+func preserveHTTP2(transport *http.Transport) {
+	if transport.Protocols == nil {
+		transport.ForceAttemptHTTP2 = true
+	}
+}
@@ -0,0 +1,151 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithHostOverride(t *testing.T) {
+	var host atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host.Store(r.Host)
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	tests := []struct {
+		name         string
+		baseURL      string
+		address      string
+		expectedHost string
+	}{
+		{name: "ip", baseURL: "http://status.example.test:" + serverURL.Port(), address: "127.0.0.1", expectedHost: "status.example.test:" + serverURL.Port()},
+		{name: "ip and port", baseURL: "http://status.example.test", address: serverURL.Host, expectedHost: "status.example.test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(tt.baseURL, WithHostOverride("status.example.test", tt.address))
+			if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+				t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+			}
+			if host.Load() != tt.expectedHost {
+				t.Errorf("Host = %v, want %v", host.Load(), tt.expectedHost)
+			}
+		})
+	}
+}
+
+func TestWithResolver(t *testing.T) {
+	var lookups atomic.Int32
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			lookups.Add(1)
+			return nil, errors.New("dns server unavailable")
+		},
+	}
+	client := NewClient("http://status.example.test", WithResolver(resolver))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err == nil {
+		t.Fatal("expected lookup to fail")
+	}
+	if lookups.Load() == 0 {
+		t.Error("expected the resolver to be used")
+	}
+	// Host overrides are applied before resolution
+	client = NewClient("http://status.example.test", WithResolver(resolver), WithHostOverride("status.example.test", "127.0.0.1:1"))
+	before := lookups.Load()
+	client.GetAllEndpointStatuses(context.Background())
+	if lookups.Load() != before {
+		t.Error("expected overridden host not to be resolved")
+	}
+}
+
+func TestDialOptions_TransportWithDialContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	// http.DefaultTransport already has a DialContext, which the options must not leave in place
+	client := NewClient("http://gatus.invalid", WithTransport(http.DefaultTransport), WithHostOverride("gatus.invalid", serverURL.Host))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+
+	var lookups atomic.Int32
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			lookups.Add(1)
+			return nil, errors.New("dns server unavailable")
+		},
+	}
+	client = NewClient("http://status.example.test", WithTransport(http.DefaultTransport), WithResolver(resolver), WithDialTimeout(2*time.Second))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err == nil {
+		t.Fatal("expected lookup to fail")
+	}
+	if lookups.Load() == 0 {
+		t.Error("expected the resolver to be used")
+	}
+	if client.netDialer.Timeout != 2*time.Second || client.netDialer.KeepAlive != DefaultDialKeepAlive {
+		t.Errorf("unexpected dialer: timeout %v, keep-alive %v", client.netDialer.Timeout, client.netDialer.KeepAlive)
+	}
+}
+
+func TestWithHostOverride_CustomTransport(t *testing.T) {
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, errors.New("unused")
+	})
+	client := NewClient("http://status.example.test", WithTransport(transport), WithHostOverride("status.example.test", "127.0.0.1"))
+	if client.netDialer != nil || client.hostOverrides != nil {
+		t.Error("expected option to have no effect on a custom transport")
+	}
+}
+
+func TestGranularTimeouts(t *testing.T) {
+	client := NewClient("https://status.example.org",
+		WithDialTimeout(2*time.Second),
+		WithTLSHandshakeTimeout(3*time.Second),
+		WithResponseHeaderTimeout(4*time.Second),
+	)
+	transport := client.transport()
+	if client.netDialer.Timeout != 2*time.Second || transport.TLSHandshakeTimeout != 3*time.Second || transport.ResponseHeaderTimeout != 4*time.Second {
+		t.Errorf("unexpected timeouts: dial %v, TLS handshake %v, response header %v", client.netDialer.Timeout, transport.TLSHandshakeTimeout, transport.ResponseHeaderTimeout)
+	}
+
+	client, err := NewClientWithConfig(Config{BaseURL: "https://status.example.org", DialTimeout: time.Second, TLSHandshakeTimeout: time.Second, ResponseHeaderTimeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport = client.transport()
+	if client.netDialer.Timeout != time.Second || transport.TLSHandshakeTimeout != time.Second || transport.ResponseHeaderTimeout != time.Second {
+		t.Error("expected timeouts to be configured from the configuration")
+	}
+}
+
+func TestWithResponseHeaderTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(server.URL, WithResponseHeaderTimeout(50*time.Millisecond))
+	start := time.Now()
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err == nil {
+		t.Fatal("expected timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request took %v, expected the response header timeout to apply", elapsed)
+	}
+}
@@ -0,0 +1,113 @@
+package gatussdk
+
+import (
+	"sort"
+)
+
+// StatusDiff describes how the endpoints of a Gatus instance differ between two points in time or two environments.
+// Keys are sorted, so that diffs can be compared and printed deterministically.
+type StatusDiff struct {
+	// Added contains the keys of the endpoints that only exist in the second set of statuses.
+	Added []string `json:"added,omitempty"`
+	// Removed contains the keys of the endpoints that only exist in the first set of statuses.
+	Removed []string `json:"removed,omitempty"`
+	// HealthChanges contains the endpoints existing in both sets whose severity changed.
+	HealthChanges []HealthChange `json:"healthChanges,omitempty"`
+	// UptimeChanges contains the uptimes, present in both snapshots, that changed.
+	UptimeChanges []UptimeChange `json:"uptimeChanges,omitempty"`
+}
+
+// HealthChange is a change of the severity of an endpoint.
+type HealthChange struct {
+	Key    string   `json:"key"`
+	Before Severity `json:"before"`
+	After  Severity `json:"after"`
+}
+
+// UptimeChange is a change of the uptime of an endpoint over a duration.
+type UptimeChange struct {
+	Key      string   `json:"key"`
+	Duration Duration `json:"duration"`
+	Before   float64  `json:"before"`
+	After    float64  `json:"after"`
+}
+
+// Delta returns the difference, in percentage points, between the uptime after and before the change.
+func (c UptimeChange) Delta() float64 {
+	return c.After - c.Before
+}
+
+// IsEmpty returns whether no difference was found.
+func (d StatusDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.HealthChanges) == 0 && len(d.UptimeChanges) == 0
+}
+
+// DiffStatuses compares two sets of endpoint statuses, reporting the endpoints added and removed,
+// and the endpoints whose severity, as computed from their latest result, changed.
+//
+// Example:
+//
+//	diff := DiffStatuses(staging, production)
+//	for _, change := range diff.HealthChanges {
+//	    fmt.Printf("%s: %s -> %s\n", change.Key, change.Before, change.After)
+//	}
+func DiffStatuses(before, after []EndpointStatus) StatusDiff {
+	var diff StatusDiff
+	beforeByKey := make(map[string]*EndpointStatus, len(before))
+	for i := range before {
+		beforeByKey[before[i].Key] = &before[i]
+	}
+	afterKeys := make(map[string]bool, len(after))
+	for i := range after {
+		status := &after[i]
+		afterKeys[status.Key] = true
+		previous, ok := beforeByKey[status.Key]
+		if !ok {
+			diff.Added = append(diff.Added, status.Key)
+			continue
+		}
+		if previousSeverity, severity := previous.Severity(), status.Severity(); previousSeverity != severity {
+			diff.HealthChanges = append(diff.HealthChanges, HealthChange{Key: status.Key, Before: previousSeverity, After: severity})
+		}
+	}
+	for _, status := range before {
+		if !afterKeys[status.Key] {
+			diff.Removed = append(diff.Removed, status.Key)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.HealthChanges, func(i, j int) bool {
+		return diff.HealthChanges[i].Key < diff.HealthChanges[j].Key
+	})
+	return diff
+}
+
+// DiffSnapshots compares two snapshots like DiffStatuses, also reporting the uptimes that changed.
+// Uptimes are only compared for endpoints and durations present in both snapshots.
+//
+// Example:
+//
+//	diff := DiffSnapshots(before, after)
+//	for _, change := range diff.UptimeChanges {
+//	    fmt.Printf("%s (%s): %+.2f points\n", change.Key, change.Duration, change.Delta())
+//	}
+func DiffSnapshots(before, after *Snapshot) StatusDiff {
+	diff := DiffStatuses(before.Endpoints, after.Endpoints)
+	for key, uptimes := range after.Uptimes {
+		for duration, uptime := range uptimes {
+			previous, ok := before.Uptime(key, duration)
+			if ok && previous != uptime {
+				diff.UptimeChanges = append(diff.UptimeChanges, UptimeChange{Key: key, Duration: duration, Before: previous, After: uptime})
+			}
+		}
+	}
+	sort.Slice(diff.UptimeChanges, func(i, j int) bool {
+		a, b := diff.UptimeChanges[i], diff.UptimeChanges[j]
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		return a.Duration < b.Duration
+	})
+	return diff
+}
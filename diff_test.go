@@ -0,0 +1,78 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffStatuses(t *testing.T) {
+	healthy := []EndpointResult{{Success: true}}
+	down := []EndpointResult{{Success: false}}
+	before := []EndpointStatus{
+		{Key: "core_api", Results: healthy},
+		{Key: "core_web", Results: healthy},
+		{Key: "core_legacy", Results: healthy},
+	}
+	after := []EndpointStatus{
+		{Key: "core_web", Results: down},
+		{Key: "core_new", Results: healthy},
+		{Key: "core_api", Results: healthy},
+	}
+	diff := DiffStatuses(before, after)
+	expected := StatusDiff{
+		Added:         []string{"core_new"},
+		Removed:       []string{"core_legacy"},
+		HealthChanges: []HealthChange{{Key: "core_web", Before: SeverityHealthy, After: SeverityDown}},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("DiffStatuses() = %+v, want %+v", diff, expected)
+	}
+	if diff.IsEmpty() || !DiffStatuses(before, before).IsEmpty() {
+		t.Error("unexpected IsEmpty() result")
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	before := &Snapshot{
+		Endpoints: []EndpointStatus{{Key: "core_api"}, {Key: "core_web"}},
+		Uptimes: map[string]map[Duration]float64{
+			"core_api": {Duration24h: 100, Duration7d: 99.5},
+			"core_web": {Duration24h: 98},
+		},
+	}
+	after := &Snapshot{
+		Endpoints: []EndpointStatus{{Key: "core_api"}, {Key: "core_web"}},
+		Uptimes: map[string]map[Duration]float64{
+			"core_api": {Duration24h: 97.5, Duration7d: 99.5, Duration30d: 99},
+			"core_web": {Duration24h: 99},
+		},
+	}
+	diff := DiffSnapshots(before, after)
+	expected := []UptimeChange{
+		{Key: "core_api", Duration: Duration24h, Before: 100, After: 97.5},
+		{Key: "core_web", Duration: Duration24h, Before: 98, After: 99},
+	}
+	if !reflect.DeepEqual(diff.UptimeChanges, expected) {
+		t.Errorf("UptimeChanges = %+v, want %+v", diff.UptimeChanges, expected)
+	}
+	if delta := diff.UptimeChanges[0].Delta(); delta != -2.5 {
+		t.Errorf("Delta() = %v, want -2.5", delta)
+	}
+}
+
+func TestStatusDiff_JSON(t *testing.T) {
+	diff := StatusDiff{HealthChanges: []HealthChange{{Key: "core_api", Before: SeverityHealthy, After: SeverityDegraded}}}
+	data, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"before":"healthy","after":"degraded"`) {
+		t.Errorf("expected severities to be encoded by name, got %s", data)
+	}
+	var decoded StatusDiff
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, diff) {
+		t.Errorf("Unmarshal() = %+v, %v, want %+v", decoded, err, diff)
+	}
+}
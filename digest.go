@@ -0,0 +1,226 @@
+package gatussdk
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+const (
+	// DefaultDigestSlowest is the default number of slowest endpoints listed in a digest.
+	DefaultDigestSlowest = 5
+	// DefaultDigestMaxIncidents is the default maximum number of incidents listed in a digest.
+	DefaultDigestMaxIncidents = 10
+)
+
+// digestTextTemplate is the template of the plaintext body of digests.
+var digestTextTemplate = template.Must(template.New("digest").Funcs(ReportTemplateFuncs()).Parse(
+	`{{.Period}} status digest, {{.Report.From.Format "Jan 2 15:04"}} to {{.Report.GeneratedAt.Format "Jan 2 15:04 MST"}}
+
+Status: {{.Report.Summary.Status}} ({{.Report.Summary.Up}}/{{.Report.Summary.Total}} endpoints healthy)
+{{- if .HasUptime}}
+Uptime: {{percent .Uptime}}
+{{- end}}
+
+Incidents ({{.IncidentCount}}):
+{{- range .Incidents}}
+- {{.Key}}: {{.Start.Format "Jan 2 15:04"}}, {{if .Resolved}}down for {{.Duration}}{{else}}ongoing{{end}}{{with .ErrorSamples}} ({{join . "; "}}){{end}}
+{{- else}}
+- None
+{{- end}}
+{{- with .Omitted}}
+- and {{.}} more
+{{- end}}
+{{- with .Slowest}}
+
+Slowest endpoints:
+{{- range .}}
+- {{.Key}}: {{milliseconds .AverageResponseTime}}
+{{- end}}
+{{- end}}
+`))
+
+// digestHTMLTemplate is the template of the HTML body of digests.
+var digestHTMLTemplate = htmltemplate.Must(htmltemplate.New("digest").Funcs(ReportTemplateFuncs()).Parse(
+	`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif">
+<h1>{{.Period}} status digest</h1>
+<p>{{.Report.From.Format "Jan 2 15:04"}} to {{.Report.GeneratedAt.Format "Jan 2 15:04 MST"}}</p>
+<p>Status: <strong>{{.Report.Summary.Status}}</strong> ({{.Report.Summary.Up}}/{{.Report.Summary.Total}} endpoints healthy)
+{{- if .HasUptime}}<br>Uptime: <strong>{{percent .Uptime}}</strong>{{end}}</p>
+<h2>Incidents ({{.IncidentCount}})</h2>
+{{if .Incidents -}}
+<table>
+<tr><th>Endpoint</th><th>Start</th><th>Duration</th><th>Errors</th></tr>
+{{- range .Incidents}}
+<tr><td>{{.Key}}</td><td>{{.Start.Format "Jan 2 15:04"}}</td><td>{{if .Resolved}}{{.Duration}}{{else}}ongoing{{end}}</td><td>{{join .ErrorSamples "; "}}</td></tr>
+{{- end}}
+</table>
+{{- with .Omitted}}
+<p>and {{.}} more</p>
+{{- end}}
+{{- else -}}
+<p>None</p>
+{{- end}}
+{{- with .Slowest}}
+<h2>Slowest endpoints</h2>
+<table>
+<tr><th>Endpoint</th><th>Avg. Response Time</th></tr>
+{{- range .}}
+<tr><td>{{.Key}}</td><td>{{milliseconds .AverageResponseTime}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// Digest is the content of a status digest email, ready to hand to any mail-sending library.
+type Digest struct {
+	// Subject is the subject of the email.
+	Subject string `json:"subject"`
+	// Text is the plaintext body of the email.
+	Text string `json:"text"`
+	// HTML is the HTML body of the email.
+	HTML string `json:"html"`
+}
+
+// DigestOptions configures a Digest. Zero values use the defaults.
+type DigestOptions struct {
+	// Slowest is the number of endpoints with the highest average response time listed. Defaults to
+	// DefaultDigestSlowest.
+	Slowest int
+	// MaxIncidents is the maximum number of incidents listed, the most recent first. The total number of incidents
+	// is always included. Defaults to DefaultDigestMaxIncidents.
+	MaxIncidents int
+}
+
+// digestIncident is an incident of an endpoint, as listed in a digest.
+type digestIncident struct {
+	// Key is the key of the endpoint.
+	Key string
+	Incident
+}
+
+// digestData is the data the digest templates are executed with.
+type digestData struct {
+	Report        *Report
+	Period        string
+	Uptime        float64
+	HasUptime     bool
+	IncidentCount int
+	Incidents     []digestIncident
+	Omitted       int
+	Slowest       []ReportEndpoint
+}
+
+// GenerateDigest generates the report of the instance over the period of reportOptions (see GenerateReport),
+// typically Duration24h for a daily digest or Duration7d for a weekly one, and summarizes it (see NewDigest).
+// Like GenerateReport, if the uptime of some endpoints could not be retrieved, the digest is generated without it,
+// and the *BatchError is returned alongside the digest.
+//
+// Example:
+//
+//	digest, err := client.GenerateDigest(ctx, ReportOptions{Duration: Duration7d}, DigestOptions{})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	message := mail.NewMessage() // Any mail-sending library
+//	message.SetHeader("Subject", digest.Subject)
+//	message.SetBody("text/plain", digest.Text)
+//	message.AddAlternative("text/html", digest.HTML)
+func (c *Client) GenerateDigest(ctx context.Context, reportOptions ReportOptions, options DigestOptions) (*Digest, error) {
+	report, reportErr := c.GenerateReport(ctx, reportOptions)
+	if report == nil {
+		return nil, reportErr
+	}
+	digest, err := NewDigest(report, options)
+	if err != nil {
+		return nil, err
+	}
+	return digest, reportErr
+}
+
+// NewDigest summarizes report into a digest: the overall status, the average uptime of the endpoints whose uptime
+// is known, the incidents of the period and the slowest endpoints. The subject summarizes the status, uptime and
+// number of incidents, e.g. "Weekly status digest: operational, 99.95% uptime, 2 incidents".
+func NewDigest(report *Report, options DigestOptions) (*Digest, error) {
+	if options.Slowest <= 0 {
+		options.Slowest = DefaultDigestSlowest
+	}
+	if options.MaxIncidents <= 0 {
+		options.MaxIncidents = DefaultDigestMaxIncidents
+	}
+	data := digestData{Report: report, Period: digestPeriod(report.Duration)}
+	var uptimes int
+	var endpoints []ReportEndpoint
+	for _, group := range report.Groups {
+		for _, endpoint := range group.Endpoints {
+			if endpoint.HasUptime {
+				data.Uptime += endpoint.Uptime
+				uptimes++
+			}
+			for _, incident := range endpoint.Incidents {
+				data.Incidents = append(data.Incidents, digestIncident{Key: endpoint.Key, Incident: incident})
+			}
+			if endpoint.AverageResponseTime > 0 {
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+	if uptimes > 0 {
+		data.Uptime /= float64(uptimes)
+		data.HasUptime = true
+	}
+	data.IncidentCount = len(data.Incidents)
+	slices.SortStableFunc(data.Incidents, func(a, b digestIncident) int {
+		return b.Start.Compare(a.Start)
+	})
+	data.Incidents = data.Incidents[:min(len(data.Incidents), options.MaxIncidents)]
+	data.Omitted = data.IncidentCount - len(data.Incidents)
+	slices.SortStableFunc(endpoints, func(a, b ReportEndpoint) int {
+		return cmp.Compare(b.AverageResponseTime, a.AverageResponseTime)
+	})
+	data.Slowest = endpoints[:min(len(endpoints), options.Slowest)]
+
+	subject := fmt.Sprintf("%s status digest: %s", data.Period, report.Summary.Status)
+	if data.HasUptime {
+		subject += fmt.Sprintf(", %.2f%% uptime", data.Uptime)
+	}
+	subject += fmt.Sprintf(", %d incident", data.IncidentCount)
+	if data.IncidentCount != 1 {
+		subject += "s"
+	}
+	digest := &Digest{Subject: subject}
+	var b strings.Builder
+	if err := digestTextTemplate.Execute(&b, data); err != nil {
+		return nil, fmt.Errorf("rendering digest: %w", err)
+	}
+	digest.Text = b.String()
+	b.Reset()
+	if err := digestHTMLTemplate.Execute(&b, data); err != nil {
+		return nil, fmt.Errorf("rendering digest: %w", err)
+	}
+	digest.HTML = b.String()
+	return digest, nil
+}
+
+// digestPeriod returns the name of the period of a digest covering duration.
+func digestPeriod(duration Duration) string {
+	switch duration {
+	case Duration1h:
+		return "Hourly"
+	case Duration24h:
+		return "Daily"
+	case Duration7d:
+		return "Weekly"
+	case Duration30d:
+		return "Monthly"
+	}
+	return string(duration)
+}
@@ -0,0 +1,102 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewDigest(t *testing.T) {
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	api := newTimedResults(now.Add(-time.Hour), 100*time.Millisecond, 200*time.Millisecond, 300*time.Millisecond)
+	api[1].Success = false
+	api[1].Errors = []string{"<timeout>"}
+	db := newTimedResults(now.Add(-2*time.Hour), 900*time.Millisecond, 800*time.Millisecond)
+	db[0].Success = false
+	db[1].Success = false
+	report := NewReport(&Snapshot{
+		Timestamp: now,
+		Endpoints: []EndpointStatus{
+			{Key: "core_api", Group: "core", Name: "api", Results: api},
+			{Key: "core_db", Group: "core", Name: "db", Results: db},
+			{Key: "core_new", Group: "core", Name: "new"},
+		},
+		Uptimes: map[string]map[Duration]float64{"core_api": {Duration24h: 99.5}, "core_db": {Duration24h: 98.5}},
+	}, ReportOptions{Duration: Duration24h})
+
+	digest, err := NewDigest(report, DigestOptions{Slowest: 1, MaxIncidents: 1})
+	if err != nil {
+		t.Fatalf("NewDigest() error = %v", err)
+	}
+	if expected := "Daily status digest: major outage, 99.00% uptime, 2 incidents"; digest.Subject != expected {
+		t.Errorf("expected subject %q, got %q", expected, digest.Subject)
+	}
+	expectedText := `Daily status digest, Jan 7 12:00 to Jan 8 12:00 UTC
+
+Status: major outage (1/3 endpoints healthy)
+Uptime: 99.00%
+
+Incidents (2):
+- core_api: Jan 8 11:01, down for 1m0s (<timeout>)
+- and 1 more
+
+Slowest endpoints:
+- core_db: 850ms
+`
+	if digest.Text != expectedText {
+		t.Errorf("got text:\n%s\nwant:\n%s", digest.Text, expectedText)
+	}
+	for _, expected := range []string{
+		"<h1>Daily status digest</h1>",
+		"<tr><td>core_api</td><td>Jan 8 11:01</td><td>1m0s</td><td>&lt;timeout&gt;</td></tr>",
+		"<p>and 1 more</p>",
+		"<tr><td>core_db</td><td>850ms</td></tr>",
+	} {
+		if !strings.Contains(digest.HTML, expected) {
+			t.Errorf("expected HTML to contain %q, got:\n%s", expected, digest.HTML)
+		}
+	}
+
+	t.Run("no incidents", func(t *testing.T) {
+		digest, err := NewDigest(&Report{Duration: Duration7d, Summary: Summary{Status: SystemStatusOperational}}, DigestOptions{})
+		if err != nil {
+			t.Fatalf("NewDigest() error = %v", err)
+		}
+		if expected := "Weekly status digest: operational, 0 incidents"; digest.Subject != expected {
+			t.Errorf("expected subject %q, got %q", expected, digest.Subject)
+		}
+		if !strings.Contains(digest.Text, "Incidents (0):\n- None\n") || !strings.Contains(digest.HTML, "<p>None</p>") {
+			t.Errorf("expected no incidents, got:\n%s\n%s", digest.Text, digest.HTML)
+		}
+	})
+}
+
+func TestClient_GenerateDigest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/endpoints/statuses":
+			w.Write([]byte(`[{"key": "core_api", "group": "core", "name": "api", "results": [{"success": true}]}]`))
+		case "/api/v1/endpoints/core_api/uptimes/24h":
+			w.Write([]byte(`1`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	digest, err := NewClient(server.URL).GenerateDigest(context.Background(), ReportOptions{Duration: Duration24h}, DigestOptions{})
+	if err != nil {
+		t.Fatalf("GenerateDigest() error = %v", err)
+	}
+	if !strings.HasPrefix(digest.Subject, "Daily status digest: operational") {
+		t.Errorf("unexpected subject %q", digest.Subject)
+	}
+
+	if _, err := NewClient(server.URL+"/missing").GenerateDigest(context.Background(), ReportOptions{}, DigestOptions{}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package gatussdk
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Duration is a time window supported by the uptime and response time endpoints.
+// Using the Duration constants instead of plain strings turns invalid durations into compile-time errors.
+type Duration string
+
+const (
+	// Duration1h is the last hour.
+	Duration1h Duration = "1h"
+	// Duration24h is the last 24 hours.
+	Duration24h Duration = "24h"
+	// Duration7d is the last 7 days.
+	Duration7d Duration = "7d"
+	// Duration30d is the last 30 days.
+	Duration30d Duration = "30d"
+)
+
+// Durations returns all durations supported by Gatus, from shortest to longest.
+func Durations() []Duration {
+	return []Duration{Duration1h, Duration24h, Duration7d, Duration30d}
+}
+
+// String returns the duration as expected by the Gatus API (e.g. "24h").
+func (d Duration) String() string {
+	return string(d)
+}
+
+// IsValid returns whether the duration is supported by Gatus.
+func (d Duration) IsValid() bool {
+	return slices.Contains(Durations(), d)
+}
+
+// GetEndpointUptimeFor is the type-safe equivalent of GetEndpointUptime.
+//
+// Example:
+//
+//	uptime, err := client.GetEndpointUptimeFor(context.Background(), "core_blog-home", gatus.Duration24h)
+func (c *Client) GetEndpointUptimeFor(ctx context.Context, key string, duration Duration, opts ...RequestOption) (float64, error) {
+	return c.GetEndpointUptime(ctx, key, string(duration), opts...)
+}
+
+// GetEndpointUptimeDataFor is the type-safe equivalent of GetEndpointUptimeData.
+//
+// Example:
+//
+//	uptimeData, err := client.GetEndpointUptimeDataFor(context.Background(), "core_blog-home", gatus.Duration7d)
+func (c *Client) GetEndpointUptimeDataFor(ctx context.Context, key string, duration Duration, opts ...RequestOption) (*UptimeData, error) {
+	return c.GetEndpointUptimeData(ctx, key, string(duration), opts...)
+}
+
+// GetEndpointResponseTimesFor is the type-safe equivalent of GetEndpointResponseTimes.
+//
+// Example:
+//
+//	respTimes, err := client.GetEndpointResponseTimesFor(context.Background(), "core_blog-home", gatus.Duration1h)
+func (c *Client) GetEndpointResponseTimesFor(ctx context.Context, key string, duration Duration, opts ...RequestOption) (*ResponseTimeData, error) {
+	return c.GetEndpointResponseTimes(ctx, key, string(duration), opts...)
+}
+
+// window returns the length of the duration, or zero if it is not supported by Gatus.
+func (d Duration) window() time.Duration {
+	switch d {
+	case Duration1h:
+		return time.Hour
+	case Duration24h:
+		return 24 * time.Hour
+	case Duration7d:
+		return 7 * 24 * time.Hour
+	case Duration30d:
+		return 30 * 24 * time.Hour
+	}
+	return 0
+}
@@ -0,0 +1,71 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDuration_IsValid(t *testing.T) {
+	for _, duration := range Durations() {
+		if !duration.IsValid() {
+			t.Errorf("expected %s to be valid", duration)
+		}
+	}
+	for _, duration := range []Duration{"", "2h", "24H", "1d"} {
+		if duration.IsValid() {
+			t.Errorf("expected %q to be invalid", duration)
+		}
+	}
+}
+
+func TestClient_TypedDurationMethods(t *testing.T) {
+	var requestedPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/endpoints/core_api/uptimes/7d":
+			json.NewEncoder(w).Encode(UptimeData{Uptime: 99.5, Duration: "7d"})
+		case "/api/v1/endpoints/core_api/response-times/1h":
+			json.NewEncoder(w).Encode(ResponseTimeData{Average: 150000000})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	uptime, err := client.GetEndpointUptimeFor(context.Background(), "core_api", Duration7d)
+	if err != nil {
+		t.Fatalf("GetEndpointUptimeFor() error = %v", err)
+	}
+	if uptime != 99.5 {
+		t.Errorf("uptime = %v, want 99.5", uptime)
+	}
+	uptimeData, err := client.GetEndpointUptimeDataFor(context.Background(), "core_api", Duration7d)
+	if err != nil {
+		t.Fatalf("GetEndpointUptimeDataFor() error = %v", err)
+	}
+	if uptimeData.Duration != "7d" {
+		t.Errorf("Duration = %v, want 7d", uptimeData.Duration)
+	}
+	responseTimes, err := client.GetEndpointResponseTimesFor(context.Background(), "core_api", Duration1h)
+	if err != nil {
+		t.Fatalf("GetEndpointResponseTimesFor() error = %v", err)
+	}
+	if responseTimes.Average != 150000000 {
+		t.Errorf("Average = %v, want 150000000", responseTimes.Average)
+	}
+	if len(requestedPaths) != 3 {
+		t.Errorf("got %d requests, want 3", len(requestedPaths))
+	}
+
+	if _, err := client.GetEndpointUptimeFor(context.Background(), "core_api", Duration("2h")); err == nil {
+		t.Error("expected validation error for unsupported duration")
+	} else if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("expected ValidationError, got %v", err)
+	}
+}
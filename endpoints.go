@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // GetAllEndpointStatuses retrieves the status of all configured endpoints.
@@ -20,8 +20,8 @@ import (
 //	for _, status := range statuses {
 //	    fmt.Printf("Endpoint: %s (Key: %s)\n", status.Name, status.Key)
 //	}
-func (c *Client) GetAllEndpointStatuses(ctx context.Context) ([]EndpointStatus, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, "/api/v1/endpoints/statuses")
+func (c *Client) GetAllEndpointStatuses(ctx context.Context, opts ...RequestOption) ([]EndpointStatus, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/api/v1/endpoints/statuses", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -41,16 +41,15 @@ func (c *Client) GetAllEndpointStatuses(ctx context.Context) ([]EndpointStatus,
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
-//	fmt.Printf("Endpoint %s is healthy: %v\n", status.Name, status.Results[0].Success)
-func (c *Client) GetEndpointStatusByKey(ctx context.Context, key string) (*EndpointStatus, error) {
-	if key == "" {
-		return nil, &ValidationError{
-			Field:   "key",
-			Message: "cannot be empty",
-		}
+//	if last := status.LastResult(); last != nil {
+//	    fmt.Printf("Endpoint %s is healthy: %v\n", status.Name, last.Success)
+//	}
+func (c *Client) GetEndpointStatusByKey(ctx context.Context, key string, opts ...RequestOption) (*EndpointStatus, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
 	}
 	path := fmt.Sprintf("/api/v1/endpoints/%s/statuses", url.PathEscape(key))
-	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +70,7 @@ func (c *Client) GetEndpointStatusByKey(ctx context.Context, key string) (*Endpo
 //	    log.Fatal(err)
 //	}
 //	fmt.Printf("Endpoint %s is healthy: %v\n", status.Name, status.Results[0].Success)
-func (c *Client) GetEndpointStatus(ctx context.Context, group, name string) (*EndpointStatus, error) {
+func (c *Client) GetEndpointStatus(ctx context.Context, group, name string, opts ...RequestOption) (*EndpointStatus, error) {
 	if name == "" {
 		return nil, &ValidationError{
 			Field:   "name",
@@ -79,46 +78,102 @@ func (c *Client) GetEndpointStatus(ctx context.Context, group, name string) (*En
 		}
 	}
 	key := GenerateKey(group, name)
-	return c.GetEndpointStatusByKey(ctx, key)
+	return c.GetEndpointStatusByKey(ctx, key, opts...)
+}
+
+// EndpointExists reports whether an endpoint with the given key exists.
+// Only the most recent result is requested, so checking a key does not download the endpoint's result history.
+//
+// Example:
+//
+//	exists, err := client.EndpointExists(context.Background(), "core_blog-home")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if !exists {
+//	    fmt.Println("Endpoint is not configured")
+//	}
+func (c *Client) EndpointExists(ctx context.Context, key string, opts ...RequestOption) (bool, error) {
+	if err := ValidateKey(key); err != nil {
+		return false, err
+	}
+	path := fmt.Sprintf("/api/v1/endpoints/%s/statuses", url.PathEscape(key))
+	resp, err := c.doRequest(ctx, http.MethodGet, path, append([]RequestOption{WithPage(1, 1)}, opts...)...)
+	if err != nil {
+		return false, err
+	}
+	if err := c.decodeResponse(resp, nil); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// IsEndpointHealthy reports whether the most recent result of an endpoint succeeded.
+// The key is generated internally using GenerateKey, and only the most recent result is requested.
+// ErrNoResults is returned if the endpoint has no results yet, and an *APIError matching ErrNotFound
+// if it doesn't exist.
+//
+// Example:
+//
+//	healthy, err := client.IsEndpointHealthy(context.Background(), "core", "blog-home")
+//	if errors.Is(err, ErrNoResults) {
+//	    fmt.Println("Endpoint has not been checked yet")
+//	} else if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) IsEndpointHealthy(ctx context.Context, group, name string, opts ...RequestOption) (bool, error) {
+	status, err := c.GetEndpointStatus(ctx, group, name, append([]RequestOption{WithPage(1, 1)}, opts...)...)
+	if err != nil {
+		return false, err
+	}
+	last := status.LastResult()
+	if last == nil {
+		return false, fmt.Errorf("endpoint %s: %w", GenerateKey(group, name), ErrNoResults)
+	}
+	return last.Success, nil
 }
 
 // GetEndpointUptimeBadgeURL returns the URL for an endpoint's uptime badge.
 // This method does not make an HTTP request, it just constructs the URL.
-// Duration must be one of: 1h, 24h, 7d, 30d.
+// Duration must be one of: 1h, 24h, 7d, 30d. The badge can be customized with BadgeOptions.
 //
 // Example:
 //
 //	url := client.GetEndpointUptimeBadgeURL("core_blog-home", "24h")
 //	// Use the URL in markdown: ![Uptime](url)
-func (c *Client) GetEndpointUptimeBadgeURL(key string, duration string) string {
-	return fmt.Sprintf("%s/api/v1/endpoints/%s/uptimes/%s/badge.svg", c.baseURL, url.PathEscape(key), url.PathEscape(duration))
+func (c *Client) GetEndpointUptimeBadgeURL(key string, duration string, options ...BadgeOptions) string {
+	return fmt.Sprintf("%s/api/v1/endpoints/%s/uptimes/%s/badge.svg", c.baseURL, url.PathEscape(key), url.PathEscape(duration)) + badgeQuery(options)
 }
 
 // GetEndpointHealthBadgeURL returns the URL for an endpoint's health badge.
 // This method does not make an HTTP request, it just constructs the URL.
+// The badge can be customized with BadgeOptions.
 //
 // Example:
 //
 //	url := client.GetEndpointHealthBadgeURL("core_blog-home")
 //	// Use the URL in markdown: ![Health](url)
-func (c *Client) GetEndpointHealthBadgeURL(key string) string {
-	return fmt.Sprintf("%s/api/v1/endpoints/%s/health/badge.svg", c.baseURL, url.PathEscape(key))
+func (c *Client) GetEndpointHealthBadgeURL(key string, options ...BadgeOptions) string {
+	return fmt.Sprintf("%s/api/v1/endpoints/%s/health/badge.svg", c.baseURL, url.PathEscape(key)) + badgeQuery(options)
 }
 
 // GetEndpointResponseTimeBadgeURL returns the URL for an endpoint's response time badge.
 // This method does not make an HTTP request, it just constructs the URL.
-// Duration must be one of: 1h, 24h, 7d, 30d.
+// Duration must be one of: 1h, 24h, 7d, 30d. The badge can be customized with BadgeOptions.
 //
 // Example:
 //
 //	url := client.GetEndpointResponseTimeBadgeURL("core_blog-home", "24h")
 //	// Use the URL in markdown: ![Response Time](url)
-func (c *Client) GetEndpointResponseTimeBadgeURL(key string, duration string) string {
-	return fmt.Sprintf("%s/api/v1/endpoints/%s/response-times/%s/badge.svg", c.baseURL, url.PathEscape(key), url.PathEscape(duration))
+func (c *Client) GetEndpointResponseTimeBadgeURL(key string, duration string, options ...BadgeOptions) string {
+	return fmt.Sprintf("%s/api/v1/endpoints/%s/response-times/%s/badge.svg", c.baseURL, url.PathEscape(key), url.PathEscape(duration)) + badgeQuery(options)
 }
 
 // GetEndpointUptime retrieves the uptime percentage for a specific endpoint.
-// Duration must be one of: 1h, 24h, 7d, 30d.
+// Duration must be one of: 1h, 24h, 7d, 30d. See GetEndpointUptimeFor for a type-safe alternative.
 //
 // Example:
 //
@@ -127,8 +182,8 @@ func (c *Client) GetEndpointResponseTimeBadgeURL(key string, duration string) st
 //	    log.Fatal(err)
 //	}
 //	fmt.Printf("Uptime: %.2f%%\n", uptime)
-func (c *Client) GetEndpointUptime(ctx context.Context, key string, duration string) (float64, error) {
-	uptimeData, err := c.GetEndpointUptimeData(ctx, key, duration)
+func (c *Client) GetEndpointUptime(ctx context.Context, key string, duration string, opts ...RequestOption) (float64, error) {
+	uptimeData, err := c.GetEndpointUptimeData(ctx, key, duration, opts...)
 	if err != nil {
 		return 0, err
 	}
@@ -136,7 +191,7 @@ func (c *Client) GetEndpointUptime(ctx context.Context, key string, duration str
 }
 
 // GetEndpointResponseTimes retrieves response time statistics for a specific endpoint.
-// Duration must be one of: 1h, 24h, 7d, 30d.
+// Duration must be one of: 1h, 24h, 7d, 30d. See GetEndpointResponseTimesFor for a type-safe alternative.
 //
 // Example:
 //
@@ -146,15 +201,12 @@ func (c *Client) GetEndpointUptime(ctx context.Context, key string, duration str
 //	}
 //	fmt.Printf("Average: %dms, Min: %dms, Max: %dms\n",
 //	    respTimes.Average/1000000, respTimes.Min/1000000, respTimes.Max/1000000)
-func (c *Client) GetEndpointResponseTimes(ctx context.Context, key string, duration string) (*ResponseTimeData, error) {
-	if key == "" {
-		return nil, &ValidationError{
-			Field:   "key",
-			Message: "cannot be empty",
-		}
+func (c *Client) GetEndpointResponseTimes(ctx context.Context, key string, duration string, opts ...RequestOption) (*ResponseTimeData, error) {
+	if err := validateKeyAndDuration(key, duration); err != nil {
+		return nil, err
 	}
 	path := fmt.Sprintf("/api/v1/endpoints/%s/response-times/%s", url.PathEscape(key), url.PathEscape(duration))
-	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +218,7 @@ func (c *Client) GetEndpointResponseTimes(ctx context.Context, key string, durat
 }
 
 // GetEndpointUptimeData retrieves raw uptime data for a specific endpoint.
-// Duration must be one of: 1h, 24h, 7d, 30d.
+// Duration must be one of: 1h, 24h, 7d, 30d. See GetEndpointUptimeDataFor for a type-safe alternative.
 //
 // Example:
 //
@@ -175,15 +227,12 @@ func (c *Client) GetEndpointResponseTimes(ctx context.Context, key string, durat
 //	    log.Fatal(err)
 //	}
 //	fmt.Printf("Uptime: %.2f%% over %s\n", uptimeData.Uptime, uptimeData.Duration)
-func (c *Client) GetEndpointUptimeData(ctx context.Context, key string, duration string) (*UptimeData, error) {
-	if key == "" {
-		return nil, &ValidationError{
-			Field:   "key",
-			Message: "cannot be empty",
-		}
+func (c *Client) GetEndpointUptimeData(ctx context.Context, key string, duration string, opts ...RequestOption) (*UptimeData, error) {
+	if err := validateKeyAndDuration(key, duration); err != nil {
+		return nil, err
 	}
 	path := fmt.Sprintf("/api/v1/endpoints/%s/uptimes/%s", url.PathEscape(key), url.PathEscape(duration))
-	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +241,7 @@ func (c *Client) GetEndpointUptimeData(ctx context.Context, key string, duration
 	if err := c.decodeResponse(resp, &data); err != nil {
 		// If that fails, try to decode as a simple float
 		// (some Gatus versions return just the percentage)
-		resp2, err2 := c.doRequest(ctx, http.MethodGet, path)
+		resp2, err2 := c.doRequest(ctx, http.MethodGet, path, opts...)
 		if err2 != nil {
 			return nil, err // Return original error
 		}
@@ -232,18 +281,19 @@ func (c *Client) GetEndpointUptimeData(ctx context.Context, key string, duration
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
-func (c *Client) PushExternalEndpointResult(ctx context.Context, key string, token string, success bool, errorMessage string, duration string) error {
-	if key == "" {
-		return &ValidationError{
-			Field:   "key",
-			Message: "cannot be empty",
-		}
+func (c *Client) PushExternalEndpointResult(ctx context.Context, key string, token string, success bool, errorMessage string, duration string, opts ...RequestOption) error {
+	var validationErrs ValidationErrors
+	if err := ValidateKey(key); err != nil {
+		validationErrs = append(validationErrs, err.(*ValidationError))
 	}
 	if token == "" {
-		return &ValidationError{
+		validationErrs = append(validationErrs, &ValidationError{
 			Field:   "token",
 			Message: "cannot be empty",
-		}
+		})
+	}
+	if err := validationErrs.errorOrNil(); err != nil {
+		return err
 	}
 	// Build query parameters
 	params := url.Values{}
@@ -255,24 +305,28 @@ func (c *Client) PushExternalEndpointResult(ctx context.Context, key string, tok
 		params.Set("duration", duration)
 	}
 	path := fmt.Sprintf("/api/v1/endpoints/%s/external?%s", url.PathEscape(key), params.Encode())
-	resp, err := c.doRequestWithAuth(ctx, http.MethodPost, path, token)
+	resp, err := c.doRequestWithAuth(ctx, http.MethodPost, path, token, opts...)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-	// Check for success status
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return &APIError{
-				StatusCode: resp.StatusCode,
-				Message:    http.StatusText(resp.StatusCode),
-			}
-		}
-		return &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(body),
+	return c.decodeResponse(resp, nil)
+}
+
+// validateKeyAndDuration validates the key and duration parameters of the uptime and response time methods.
+func validateKeyAndDuration(key, duration string) error {
+	var validationErrs ValidationErrors
+	if err := ValidateKey(key); err != nil {
+		validationErrs = append(validationErrs, err.(*ValidationError))
+	}
+	if !Duration(duration).IsValid() {
+		validDurations := make([]string, 0, len(Durations()))
+		for _, validDuration := range Durations() {
+			validDurations = append(validDurations, validDuration.String())
 		}
+		validationErrs = append(validationErrs, &ValidationError{
+			Field:   "duration",
+			Message: fmt.Sprintf("must be one of %s", strings.Join(validDurations, ", ")),
+		})
 	}
-	return nil
+	return validationErrs.errorOrNil()
 }
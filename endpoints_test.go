@@ -1,8 +1,10 @@
 package gatussdk
 
 import (
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -202,6 +204,19 @@ func TestClient_GetEndpointStatusByKey(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "malformed key",
+			key:  "core/api",
+			serverResponse: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("expected no request for a malformed key")
+			},
+			expectedError: true,
+			checkError: func(t *testing.T, err error) {
+				if _, ok := err.(*ValidationError); !ok {
+					t.Errorf("expected ValidationError, got %v", err)
+				}
+			},
+		},
 		{
 			name: "endpoint not found",
 			key:  "nonexistent_endpoint",
@@ -342,6 +357,88 @@ func TestClient_GetEndpointStatus(t *testing.T) {
 	}
 }
 
+func TestClient_EndpointExists(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageSize") != "1" {
+			t.Errorf("pageSize = %v, want 1", r.URL.Query().Get("pageSize"))
+		}
+		switch r.URL.Path {
+		case "/api/v1/endpoints/core_api/statuses":
+			json.NewEncoder(w).Encode(EndpointStatus{Key: "core_api"})
+		case "/api/v1/endpoints/core_broken/statuses":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	tests := []struct {
+		key            string
+		expectedExists bool
+		expectedError  bool
+	}{
+		{key: "core_api", expectedExists: true},
+		{key: "core_missing", expectedExists: false},
+		{key: "core_broken", expectedError: true},
+		{key: "invalid", expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			exists, err := client.EndpointExists(context.Background(), tt.key)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("EndpointExists() error = %v, expectedError %v", err, tt.expectedError)
+			}
+			if exists != tt.expectedExists {
+				t.Errorf("EndpointExists() = %v, want %v", exists, tt.expectedExists)
+			}
+		})
+	}
+}
+
+func TestClient_IsEndpointHealthy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageSize") != "1" {
+			t.Errorf("pageSize = %v, want 1", r.URL.Query().Get("pageSize"))
+		}
+		switch r.URL.Path {
+		case "/api/v1/endpoints/core_up/statuses":
+			json.NewEncoder(w).Encode(EndpointStatus{Key: "core_up", Results: []EndpointResult{{Success: true}}})
+		case "/api/v1/endpoints/core_down/statuses":
+			json.NewEncoder(w).Encode(EndpointStatus{Key: "core_down", Results: []EndpointResult{{Success: false}}})
+		case "/api/v1/endpoints/core_new/statuses":
+			json.NewEncoder(w).Encode(EndpointStatus{Key: "core_new", Results: []EndpointResult{}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	tests := []struct {
+		name            string
+		expectedHealthy bool
+		expectedError   error
+	}{
+		{name: "up", expectedHealthy: true},
+		{name: "down", expectedHealthy: false},
+		{name: "new", expectedError: ErrNoResults},
+		{name: "missing", expectedError: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthy, err := client.IsEndpointHealthy(context.Background(), "core", tt.name)
+			if !errors.Is(err, tt.expectedError) || (err != nil) != (tt.expectedError != nil) {
+				t.Fatalf("IsEndpointHealthy() error = %v, want %v", err, tt.expectedError)
+			}
+			if healthy != tt.expectedHealthy {
+				t.Errorf("IsEndpointHealthy() = %v, want %v", healthy, tt.expectedHealthy)
+			}
+		})
+	}
+}
+
 func TestClient_BadgeURLs(t *testing.T) {
 	client := NewClient("https://status.example.com")
 
@@ -686,7 +783,7 @@ func TestClient_GetEndpointUptimeData(t *testing.T) {
 		},
 		{
 			name:     "404 not found",
-			key:      "nonexistent",
+			key:      "core_nonexistent",
 			duration: "24h",
 			serverResponse: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNotFound)
@@ -938,3 +1035,29 @@ func TestClient_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_PushExternalEndpointResult_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusBadRequest)
+		writer := gzip.NewWriter(w)
+		writer.Write([]byte(`{"error": "invalid duration"}`))
+		writer.Close()
+	}))
+	defer server.Close()
+
+	err := NewClient(server.URL).PushExternalEndpointResult(context.Background(), "core_ext", "token", true, "", "forever")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected APIError with status code 400, got %v", err)
+	}
+	if apiErr.APIMessage != "invalid duration" {
+		t.Errorf("expected the decompressed API message, got %q (message %q)", apiErr.APIMessage, apiErr.Message)
+	}
+
+	var tooLarge *ResponseTooLargeError
+	if err := NewClient(server.URL, WithMaxResponseSize(4)).PushExternalEndpointResult(context.Background(), "core_ext", "token", true, "", ""); !errors.As(err, &tooLarge) {
+		t.Errorf("expected ResponseTooLargeError, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package gatussdk
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	// EnvURL is the environment variable containing the base URL of the Gatus instance. It is required.
+	EnvURL = "GATUS_URL"
+	// EnvToken is the environment variable containing the bearer token sent with every request (see WithBearerToken).
+	EnvToken = "GATUS_TOKEN"
+	// EnvTimeout is the environment variable containing the client timeout, as a Go duration (e.g. "10s").
+	EnvTimeout = "GATUS_TIMEOUT"
+	// EnvUserAgent is the environment variable containing the User-Agent header sent with every request.
+	EnvUserAgent = "GATUS_USER_AGENT"
+)
+
+// NewClientFromEnv creates a new Gatus API client configured through environment variables,
+// which is convenient for small tools and CI jobs:
+//   - GATUS_URL: base URL of the Gatus instance (required)
+//   - GATUS_TOKEN: bearer token sent with every request
+//   - GATUS_TIMEOUT: client timeout, as a Go duration (e.g. "10s")
+//   - GATUS_USER_AGENT: User-Agent header sent with every request
+//
+// Unset variables leave the defaults in place. The given options are applied after the environment variables,
+// so they take precedence. A *ValidationError is returned if GATUS_URL is unset or a variable is invalid.
+//
+// Example:
+//
+//	client, err := gatus.NewClientFromEnv()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	config := Config{
+		BaseURL:   os.Getenv(EnvURL),
+		UserAgent: os.Getenv(EnvUserAgent),
+		Auth:      AuthConfig{BearerToken: os.Getenv(EnvToken)},
+	}
+	if config.BaseURL == "" {
+		return nil, &ValidationError{
+			Field:   EnvURL,
+			Message: "cannot be empty",
+		}
+	}
+	if value := os.Getenv(EnvTimeout); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return nil, &ValidationError{
+				Field:   EnvTimeout,
+				Message: fmt.Sprintf("must be a positive duration, got %q", value),
+			}
+		}
+		config.Timeout = timeout
+	}
+	return NewClientWithConfig(config, opts...)
+}
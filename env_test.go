@@ -0,0 +1,73 @@
+package gatussdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewClientFromEnv(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer env-token" {
+			t.Errorf("Authorization = %v, want Bearer env-token", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get("User-Agent") != "CI/1.0" {
+			t.Errorf("User-Agent = %v, want CI/1.0", r.Header.Get("User-Agent"))
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	t.Setenv(EnvURL, server.URL)
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvTimeout, "3s")
+	t.Setenv(EnvUserAgent, "CI/1.0")
+
+	client, err := NewClientFromEnv()
+	if err != nil {
+		t.Fatalf("NewClientFromEnv() error = %v", err)
+	}
+	if client.httpClient.Timeout != 3*time.Second {
+		t.Errorf("Timeout = %v, want 3s", client.httpClient.Timeout)
+	}
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+
+	// Options take precedence over environment variables
+	client, err = NewClientFromEnv(WithTimeout(time.Minute))
+	if err != nil {
+		t.Fatalf("NewClientFromEnv() error = %v", err)
+	}
+	if client.httpClient.Timeout != time.Minute {
+		t.Errorf("Timeout = %v, want 1m", client.httpClient.Timeout)
+	}
+}
+
+func TestNewClientFromEnv_Invalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		timeout       string
+		expectedField string
+	}{
+		{name: "missing url", url: "", expectedField: EnvURL},
+		{name: "invalid timeout", url: "https://status.example.org", timeout: "soon", expectedField: EnvTimeout},
+		{name: "negative timeout", url: "https://status.example.org", timeout: "-1s", expectedField: EnvTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvURL, tt.url)
+			t.Setenv(EnvTimeout, tt.timeout)
+			_, err := NewClientFromEnv()
+			validationErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if validationErr.Field != tt.expectedField {
+				t.Errorf("Field = %v, want %v", validationErr.Field, tt.expectedField)
+			}
+		})
+	}
+}
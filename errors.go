@@ -1,7 +1,43 @@
 package gatussdk
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrNotFound is matched by an *APIError with a 404 Not Found status code.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is matched by an *APIError with a 401 Unauthorized status code.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is matched by an *APIError with a 403 Forbidden status code.
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited is matched by an *APIError with a 429 Too Many Requests status code.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrNonJSONResponse is matched by a *NonJSONResponseError, returned when a response that should be JSON
+	// is not, typically because a proxy in front of Gatus returned an HTML error or login page.
+	ErrNonJSONResponse = errors.New("non-JSON response")
+	// ErrNoResults is returned when the health of an endpoint is requested but it has no results yet,
+	// for instance because Gatus has not checked it since it started.
+	ErrNoResults = errors.New("no results")
+	// ErrUnhealthy is matched by an *UnhealthyEndpointError, returned by AssertAllHealthy and AssertGroupHealthy
+	// for each endpoint whose most recent result failed.
+	ErrUnhealthy = errors.New("unhealthy")
 )
 
 // APIError represents an error returned by the Gatus API.
@@ -10,16 +46,121 @@ type APIError struct {
 	StatusCode int
 	// Message is a human-readable error message.
 	Message string
-	// Body contains the raw response body from the API.
+	// Body contains the raw response body from the API. If the body is an HTML or XML document, such as
+	// the error page of a proxy, it contains a preview of it instead (see NonJSONResponseError).
 	Body string
+	// APIMessage is the error message parsed from the response body if it is a Gatus error response
+	// (e.g. {"error": "..."}), or empty otherwise. Unlike Body, it is suitable for display to users.
+	APIMessage string
+	// RequestMethod is the HTTP method of the request that failed.
+	RequestMethod string
+	// RequestURL is the URL of the request that failed, with credentials redacted.
+	RequestURL string
+	// RequestID is the ID sent in the X-Request-ID header of the request that failed, if any.
+	RequestID string
+	// RetryAfter is how long the server asked the client to wait before retrying, as specified by the
+	// Retry-After header. It is typically set on 429 Too Many Requests and 503 Service Unavailable responses,
+	// and is zero if the header was absent or invalid.
+	RetryAfter time.Duration
+	// Err is the underlying error that occurred while handling the response, if any
+	// (e.g. a *ResponseTooLargeError if the body was truncated).
+	Err error
 }
 
 // Error returns a formatted error message.
+// The request method, URL and ID are included if known.
 func (e *APIError) Error() string {
+	prefix := "API error"
+	if e.RequestMethod != "" || e.RequestURL != "" {
+		prefix = strings.TrimSpace(fmt.Sprintf("API error: %s %s", e.RequestMethod, e.RequestURL))
+	}
+	if e.RequestID != "" {
+		prefix = fmt.Sprintf("%s (request ID %s)", prefix, e.RequestID)
+	}
 	if e.Body != "" {
-		return fmt.Sprintf("API error: status %d: %s (body: %s)", e.StatusCode, e.Message, e.Body)
+		return fmt.Sprintf("%s: status %d: %s (body: %s)", prefix, e.StatusCode, e.Message, e.Body)
 	}
-	return fmt.Sprintf("API error: status %d: %s", e.StatusCode, e.Message)
+	return fmt.Sprintf("%s: status %d: %s", prefix, e.StatusCode, e.Message)
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// setRequest sets the request method and redacted URL from the request that failed, if known.
+// It must be called after the message and body are set, so that credentials sent with the request
+// are redacted from them in case the server echoed them back.
+func (e *APIError) setRequest(req *http.Request) {
+	if req == nil {
+		return
+	}
+	e.RequestMethod = req.Method
+	e.RequestURL = redactURL(req.URL)
+	e.RequestID = req.Header.Get(RequestIDHeader)
+	secrets := requestSecrets(req)
+	e.Message = redactSecrets(e.Message, secrets)
+	e.Body = redactSecrets(e.Body, secrets)
+	e.APIMessage = redactSecrets(e.APIMessage, secrets)
+}
+
+// setBody sets the raw body and, if the body is a Gatus error response, the parsed API message.
+func (e *APIError) setBody(body []byte) {
+	e.Body = string(body)
+	e.APIMessage = parseAPIMessage(body)
+}
+
+// parseAPIMessage returns the error message of a Gatus error response, or an empty string if body isn't one.
+func parseAPIMessage(body []byte) string {
+	var errorResponse ErrorResponse
+	if err := json.Unmarshal(body, &errorResponse); err != nil {
+		return ""
+	}
+	return errorResponse.Error
+}
+
+// IsClientError returns whether the status code is in the 4xx range.
+func (e *APIError) IsClientError() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500
+}
+
+// IsServerError returns whether the status code is in the 5xx range.
+func (e *APIError) IsServerError() bool {
+	return e.StatusCode >= 500 && e.StatusCode < 600
+}
+
+// Temporary returns whether the error is likely transient, meaning the same request may succeed if retried later.
+// This is the case for timeouts, rate limiting and server-side failures such as bad gateways.
+func (e *APIError) Temporary() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooEarly, http.StatusTooManyRequests,
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Is reports whether the error matches the sentinel error corresponding to its status code,
+// allowing callers to use errors.Is instead of comparing status codes.
+//
+// Example:
+//
+//	_, err := client.GetEndpointStatusByKey(ctx, "core_missing")
+//	if errors.Is(err, ErrNotFound) {
+//	    // ...
+//	}
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
 }
 
 // ValidationError represents a validation error for input parameters.
@@ -34,3 +175,262 @@ type ValidationError struct {
 func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation error: field '%s': %s", e.Field, e.Message)
 }
+
+// ValidationErrors represents multiple validation errors for input parameters.
+// It is returned instead of a single *ValidationError when more than one parameter is invalid.
+type ValidationErrors []*ValidationError
+
+// Error returns the validation error messages joined together.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, fmt.Sprintf("field '%s': %s", err.Field, err.Message))
+	}
+	return fmt.Sprintf("validation errors: %s", strings.Join(messages, "; "))
+}
+
+// Unwrap returns the individual validation errors, allowing errors.As to match a *ValidationError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// errorOrNil returns nil if there are no validation errors, the *ValidationError if there is exactly one,
+// and the ValidationErrors otherwise.
+func (e ValidationErrors) errorOrNil() error {
+	switch len(e) {
+	case 0:
+		return nil
+	case 1:
+		return e[0]
+	}
+	return e
+}
+
+// ResponseTooLargeError is returned when a response body exceeds the size configured with WithMaxResponseSize.
+type ResponseTooLargeError struct {
+	// Limit is the maximum response size in bytes.
+	Limit int64
+}
+
+// Error returns a formatted error message.
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds maximum size of %d bytes", e.Limit)
+}
+
+// BatchError represents the errors that occurred for individual keys during a batch operation.
+type BatchError struct {
+	// Errors maps each key that failed to the error that occurred.
+	Errors map[string]error
+}
+
+// Error returns a formatted error message listing the error for each failed key, sorted by key.
+func (e *BatchError) Error() string {
+	keys := make([]string, 0, len(e.Errors))
+	for key := range e.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	messages := make([]string, 0, len(keys))
+	for _, key := range keys {
+		messages = append(messages, fmt.Sprintf("%s: %v", key, e.Errors[key]))
+	}
+	return fmt.Sprintf("batch error: %d failed: %s", len(keys), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the individual errors, allowing errors.Is and errors.As to match any of them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// UnhealthyEndpointError reports an endpoint whose most recent result failed.
+type UnhealthyEndpointError struct {
+	// Key is the key of the endpoint.
+	Key string
+	// Result is the most recent result of the endpoint.
+	Result EndpointResult
+}
+
+// Error returns a formatted error message listing the errors and failed conditions of the result.
+func (e *UnhealthyEndpointError) Error() string {
+	reasons := slices.Clone(e.Result.Errors)
+	for _, condition := range e.Result.ConditionResults {
+		if !condition.Success {
+			reasons = append(reasons, "condition "+condition.Condition+" failed")
+		}
+	}
+	if len(reasons) == 0 {
+		return fmt.Sprintf("endpoint %s is unhealthy", e.Key)
+	}
+	return fmt.Sprintf("endpoint %s is unhealthy: %s", e.Key, strings.Join(reasons, "; "))
+}
+
+// Is reports whether target is ErrUnhealthy.
+func (e *UnhealthyEndpointError) Is(target error) bool {
+	return target == ErrUnhealthy
+}
+
+// IsRetryable reports whether the operation that returned err may succeed if retried.
+//
+// Errors are classified as follows:
+//   - API errors are retryable if they are temporary (see APIError.Temporary), e.g. 429 and most 5xx responses,
+//     but not other 4xx responses
+//   - network errors, such as connection failures, timeouts and connections closed mid-response, are retryable
+//   - validation errors, oversized responses, decoding errors and context cancellations are not retryable
+//
+// The SDK uses the same classification to decide which requests to retry (see WithRetry), so callers
+// implementing their own retry loops can use it to classify errors consistently with the SDK.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var validationErr *ValidationError
+	var tooLargeErr *ResponseTooLargeError
+	if errors.As(err, &validationErr) || errors.As(err, &tooLargeErr) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+// It returns zero if the value is empty, invalid or in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// MaxNonJSONPreviewLength is the maximum length of the preview of a non-JSON response body.
+const MaxNonJSONPreviewLength = 200
+
+// NonJSONResponseError is returned when a response that should be JSON is not.
+// For responses with a non-2xx status code, it is set as the Err of the returned *APIError,
+// whose Body is then the preview rather than the complete body.
+type NonJSONResponseError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// ContentType is the Content-Type header of the response.
+	ContentType string
+	// Title is the title of the page if the response is an HTML document, e.g. "502 Bad Gateway".
+	Title string
+	// Preview is the beginning of the body, with consecutive whitespace collapsed and truncated to
+	// MaxNonJSONPreviewLength bytes.
+	Preview string
+}
+
+// Error returns a concise error message, using the title of HTML pages when available.
+func (e *NonJSONResponseError) Error() string {
+	summary := e.Title
+	if summary == "" {
+		summary = e.Preview
+	}
+	message := fmt.Sprintf("non-JSON response: status %d", e.StatusCode)
+	if e.ContentType != "" {
+		message += fmt.Sprintf(", content type %s", e.ContentType)
+	}
+	if summary != "" {
+		message += ": " + summary
+	}
+	return message
+}
+
+// Is reports whether target is ErrNonJSONResponse.
+func (e *NonJSONResponseError) Is(target error) bool {
+	return target == ErrNonJSONResponse
+}
+
+// htmlTitlePattern matches the title of an HTML document.
+var htmlTitlePattern = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+// newNonJSONResponseError creates a *NonJSONResponseError from the response and the beginning of its body.
+func newNonJSONResponseError(resp *http.Response, body []byte) *NonJSONResponseError {
+	err := &NonJSONResponseError{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Preview:     preview(string(body), MaxNonJSONPreviewLength),
+	}
+	if match := htmlTitlePattern.FindSubmatch(body); match != nil {
+		err.Title = preview(string(match[1]), MaxNonJSONPreviewLength)
+	}
+	if resp.Request != nil {
+		// Redact credentials sent with the request in case the server echoed them back
+		secrets := requestSecrets(resp.Request)
+		err.Title = redactSecrets(err.Title, secrets)
+		err.Preview = redactSecrets(err.Preview, secrets)
+	}
+	return err
+}
+
+// preview collapses consecutive whitespace in s and truncates it to at most maxLength bytes,
+// without splitting a multi-byte character.
+func preview(s string, maxLength int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if len(s) <= maxLength {
+		return s
+	}
+	const ellipsis = "..."
+	end := maxLength - len(ellipsis)
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + ellipsis
+}
+
+// maxNonJSONCapture is the number of bytes of a response body captured to build a *NonJSONResponseError,
+// which is enough to include the title of typical HTML error pages.
+const maxNonJSONCapture = 4096
+
+// isNonJSONBody reports whether a response with the given Content-Type header and body, or beginning of body,
+// is an HTML or XML document rather than JSON. Since servers often omit the Content-Type header or send
+// text/plain for JSON, a body starting with a markup tag is also considered non-JSON.
+func isNonJSONBody(contentType string, body []byte) bool {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if mediaType == "text/html" || mediaType == "application/xhtml+xml" || strings.HasSuffix(mediaType, "/xml") {
+			return true
+		}
+	}
+	return bytes.HasPrefix(bytes.TrimLeft(body, " \t\r\n"), []byte("<"))
+}
+
+// captureWriter keeps the first limit bytes written to it.
+type captureWriter struct {
+	data  []byte
+	limit int
+}
+
+// Write implements io.Writer. It never fails, so that it can be used with io.TeeReader.
+func (w *captureWriter) Write(p []byte) (int, error) {
+	if remaining := w.limit - len(w.data); remaining > 0 {
+		w.data = append(w.data, p[:min(len(p), remaining)]...)
+	}
+	return len(p), nil
+}
@@ -1,8 +1,17 @@
 package gatussdk
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestAPIError_Error(t *testing.T) {
@@ -232,3 +241,419 @@ func TestErrorTypes(t *testing.T) {
 		_ = valErr.Error()
 	})
 }
+
+func TestAPIError_Is(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited}
+	tests := []struct {
+		statusCode int
+		expected   error
+	}{
+		{statusCode: http.StatusNotFound, expected: ErrNotFound},
+		{statusCode: http.StatusUnauthorized, expected: ErrUnauthorized},
+		{statusCode: http.StatusForbidden, expected: ErrForbidden},
+		{statusCode: http.StatusTooManyRequests, expected: ErrRateLimited},
+		{statusCode: http.StatusInternalServerError, expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", &APIError{StatusCode: tt.statusCode})
+			for _, sentinel := range sentinels {
+				if errors.Is(err, sentinel) != (sentinel == tt.expected) {
+					t.Errorf("errors.Is(err, %v) = %v, want %v", sentinel, !(sentinel == tt.expected), sentinel == tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIError_StatusClasses(t *testing.T) {
+	tests := []struct {
+		statusCode          int
+		expectedClientError bool
+		expectedServerError bool
+		expectedTemporary   bool
+	}{
+		{statusCode: http.StatusBadRequest, expectedClientError: true},
+		{statusCode: http.StatusNotFound, expectedClientError: true},
+		{statusCode: http.StatusRequestTimeout, expectedClientError: true, expectedTemporary: true},
+		{statusCode: http.StatusTooManyRequests, expectedClientError: true, expectedTemporary: true},
+		{statusCode: http.StatusInternalServerError, expectedServerError: true, expectedTemporary: true},
+		{statusCode: http.StatusNotImplemented, expectedServerError: true},
+		{statusCode: http.StatusBadGateway, expectedServerError: true, expectedTemporary: true},
+		{statusCode: http.StatusServiceUnavailable, expectedServerError: true, expectedTemporary: true},
+		{statusCode: http.StatusGatewayTimeout, expectedServerError: true, expectedTemporary: true},
+		{statusCode: http.StatusFound},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			err := &APIError{StatusCode: tt.statusCode}
+			if err.IsClientError() != tt.expectedClientError {
+				t.Errorf("IsClientError() = %v, want %v", err.IsClientError(), tt.expectedClientError)
+			}
+			if err.IsServerError() != tt.expectedServerError {
+				t.Errorf("IsServerError() = %v, want %v", err.IsServerError(), tt.expectedServerError)
+			}
+			if err.Temporary() != tt.expectedTemporary {
+				t.Errorf("Temporary() = %v, want %v", err.Temporary(), tt.expectedTemporary)
+			}
+		})
+	}
+}
+
+func TestAPIError_Unwrap(t *testing.T) {
+	cause := &ResponseTooLargeError{Limit: 10}
+	err := fmt.Errorf("retrying: %w", &APIError{StatusCode: http.StatusBadGateway, Err: cause})
+	var tooLargeErr *ResponseTooLargeError
+	if !errors.As(err, &tooLargeErr) || tooLargeErr != cause {
+		t.Errorf("expected errors.As to find the underlying error, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected errors.As to find the APIError, got %v", err)
+	}
+	if (&APIError{}).Unwrap() != nil {
+		t.Error("expected nil underlying error")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "empty", value: "", expected: 0},
+		{name: "seconds", value: "120", expected: 2 * time.Minute},
+		{name: "negative seconds", value: "-5", expected: 0},
+		{name: "http date", value: now.Add(30 * time.Second).Format(http.TimeFormat), expected: 30 * time.Second},
+		{name: "http date in the past", value: now.Add(-time.Hour).Format(http.TimeFormat), expected: 0},
+		{name: "invalid", value: "soon", expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := parseRetryAfter(tt.value, now); actual != tt.expected {
+				t.Errorf("parseRetryAfter() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAPIError_RetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	_, err := client.GetAllEndpointStatuses(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != time.Minute {
+		t.Errorf("expected APIError with RetryAfter = 1m, got %v", err)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected error to match ErrRateLimited, got %v", err)
+	}
+
+	err = client.PushExternalEndpointResult(context.Background(), "core_ext", "token", true, "", "")
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != time.Minute {
+		t.Errorf("expected APIError with RetryAfter = 1m, got %v", err)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "key", Message: "cannot be empty"},
+		{Field: "duration", Message: "must be one of 1h, 24h, 7d, 30d"},
+	}
+	expected := "validation errors: field 'key': cannot be empty; field 'duration': must be one of 1h, 24h, 7d, 30d"
+	if errs.Error() != expected {
+		t.Errorf("Error() = %q, want %q", errs.Error(), expected)
+	}
+	var valErr *ValidationError
+	if !errors.As(error(errs), &valErr) || valErr.Field != "key" {
+		t.Errorf("expected errors.As to find the first ValidationError, got %v", valErr)
+	}
+	if ValidationErrors(nil).errorOrNil() != nil {
+		t.Error("expected nil error for no validation errors")
+	}
+	if _, ok := errs[:1].errorOrNil().(*ValidationError); !ok {
+		t.Error("expected a single *ValidationError for one validation error")
+	}
+}
+
+func TestClient_AggregatedValidationErrors(t *testing.T) {
+	client := NewClient("https://example.com")
+	tests := []struct {
+		name           string
+		call           func() error
+		expectedFields []string
+	}{
+		{
+			name: "uptime with empty key and invalid duration",
+			call: func() error {
+				_, err := client.GetEndpointUptimeData(context.Background(), "", "2d")
+				return err
+			},
+			expectedFields: []string{"key", "duration"},
+		},
+		{
+			name: "response times with invalid duration",
+			call: func() error {
+				_, err := client.GetEndpointResponseTimes(context.Background(), "core_api", "")
+				return err
+			},
+			expectedFields: []string{"duration"},
+		},
+		{
+			name: "push with empty key and token",
+			call: func() error {
+				return client.PushExternalEndpointResult(context.Background(), "", "", true, "", "")
+			},
+			expectedFields: []string{"key", "token"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			var fields []string
+			switch e := err.(type) {
+			case *ValidationError:
+				fields = []string{e.Field}
+			case ValidationErrors:
+				for _, valErr := range e {
+					fields = append(fields, valErr.Field)
+				}
+			default:
+				t.Fatalf("expected validation error, got %v", err)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.expectedFields, ",") {
+				t.Errorf("fields = %v, want %v", fields, tt.expectedFields)
+			}
+		})
+	}
+}
+
+func TestAPIError_Request(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	baseURL := strings.Replace(server.URL, "http://", "http://user:password@", 1)
+	client := NewClient(baseURL)
+	_, err := client.GetEndpointStatusByKey(context.Background(), "core_missing")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.RequestMethod != http.MethodGet {
+		t.Errorf("RequestMethod = %v, want GET", apiErr.RequestMethod)
+	}
+	if !strings.HasSuffix(apiErr.RequestURL, "/api/v1/endpoints/core_missing/statuses") {
+		t.Errorf("RequestURL = %v", apiErr.RequestURL)
+	}
+	if strings.Contains(err.Error(), "password") {
+		t.Errorf("error leaked credentials: %v", err)
+	}
+	expectedPrefix := "API error: GET " + apiErr.RequestURL + ": status 404"
+	if !strings.HasPrefix(err.Error(), expectedPrefix) {
+		t.Errorf("Error() = %v, want prefix %v", err.Error(), expectedPrefix)
+	}
+
+	err = client.PushExternalEndpointResult(context.Background(), "core_ext", "secret-token", true, "", "")
+	if !errors.As(err, &apiErr) || apiErr.RequestMethod != http.MethodPost {
+		t.Fatalf("expected APIError for POST request, got %v", err)
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaked token: %v", err)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "validation error", err: &ValidationError{Field: "key", Message: "cannot be empty"}, expected: false},
+		{name: "validation errors", err: ValidationErrors{{Field: "key"}, {Field: "duration"}}, expected: false},
+		{name: "not found", err: &APIError{StatusCode: http.StatusNotFound}, expected: false},
+		{name: "unauthorized", err: &APIError{StatusCode: http.StatusUnauthorized}, expected: false},
+		{name: "rate limited", err: &APIError{StatusCode: http.StatusTooManyRequests}, expected: true},
+		{name: "bad gateway", err: &APIError{StatusCode: http.StatusBadGateway}, expected: true},
+		{name: "wrapped service unavailable", err: fmt.Errorf("wrapped: %w", &APIError{StatusCode: http.StatusServiceUnavailable}), expected: true},
+		{name: "not implemented", err: &APIError{StatusCode: http.StatusNotImplemented}, expected: false},
+		{name: "response too large", err: &ResponseTooLargeError{Limit: 10}, expected: false},
+		{name: "context canceled", err: fmt.Errorf("executing request: %w", context.Canceled), expected: false},
+		{name: "context deadline exceeded", err: fmt.Errorf("executing request: %w", context.DeadlineExceeded), expected: false},
+		{name: "unexpected EOF", err: fmt.Errorf("decoding response: %w", io.ErrUnexpectedEOF), expected: true},
+		{name: "network error", err: fmt.Errorf("executing request: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), expected: true},
+		{name: "decoding error", err: fmt.Errorf("decoding response: %w", &json.SyntaxError{}), expected: false},
+		{name: "unknown error", err: errors.New("boom"), expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := IsRetryable(tt.err); actual != tt.expected {
+				t.Errorf("IsRetryable() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+
+	t.Run("unreachable host", func(t *testing.T) {
+		_, err := NewClient("http://127.0.0.1:0").GetAllEndpointStatuses(context.Background())
+		if !IsRetryable(err) {
+			t.Errorf("expected network error to be retryable, got %v", err)
+		}
+	})
+}
+
+func TestAPIError_APIMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{name: "gatus error response", body: `{"error": "endpoint not found"}`, expected: "endpoint not found"},
+		{name: "plain text", body: "internal server error", expected: ""},
+		{name: "json without error", body: `{"message": "nope"}`, expected: ""},
+		{name: "empty", body: "", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL)
+			_, err := client.GetAllEndpointStatuses(context.Background())
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.APIMessage != tt.expected {
+				t.Errorf("APIMessage = %q, want %q", apiErr.APIMessage, tt.expected)
+			}
+			if apiErr.Body != tt.body {
+				t.Errorf("Body = %q, want %q", apiErr.Body, tt.body)
+			}
+			err = client.PushExternalEndpointResult(context.Background(), "core_ext", "token", true, "", "")
+			if !errors.As(err, &apiErr) || apiErr.APIMessage != tt.expected {
+				t.Errorf("expected push APIMessage = %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestNonJSONResponseError(t *testing.T) {
+	tests := []struct {
+		name            string
+		contentType     string
+		body            string
+		expectedTitle   string
+		expectedPreview string
+		expectedError   string
+	}{
+		{
+			name:            "html with title",
+			contentType:     "text/html; charset=utf-8",
+			body:            "<html>\n  <head><title>\n 502 Bad Gateway </title></head>\n</html>",
+			expectedTitle:   "502 Bad Gateway",
+			expectedPreview: "<html> <head><title> 502 Bad Gateway </title></head> </html>",
+			expectedError:   "non-JSON response: status 502, content type text/html; charset=utf-8: 502 Bad Gateway",
+		},
+		{
+			name:            "xml without title",
+			body:            "<error>bad gateway</error>",
+			expectedPreview: "<error>bad gateway</error>",
+			expectedError:   "non-JSON response: status 502: <error>bad gateway</error>",
+		},
+		{
+			name:            "truncated",
+			body:            "<p>" + strings.Repeat("é", 200),
+			expectedPreview: "<p>" + strings.Repeat("é", 97) + "...",
+			expectedError:   "non-JSON response: status 502: <p>" + strings.Repeat("é", 97) + "...",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: http.StatusBadGateway, Header: make(http.Header)}
+			if tt.contentType != "" {
+				resp.Header.Set("Content-Type", tt.contentType)
+			}
+			err := newNonJSONResponseError(resp, []byte(tt.body))
+			if err.Title != tt.expectedTitle {
+				t.Errorf("Title = %q, want %q", err.Title, tt.expectedTitle)
+			}
+			if err.Preview != tt.expectedPreview {
+				t.Errorf("Preview = %q, want %q", err.Preview, tt.expectedPreview)
+			}
+			if err.Error() != tt.expectedError {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.expectedError)
+			}
+			if !errors.Is(err, ErrNonJSONResponse) {
+				t.Error("expected error to match ErrNonJSONResponse")
+			}
+		})
+	}
+}
+
+func TestIsNonJSONBody(t *testing.T) {
+	tests := []struct {
+		contentType string
+		body        string
+		expected    bool
+	}{
+		{contentType: "text/html", body: "", expected: true},
+		{contentType: "application/xml", body: "", expected: true},
+		{contentType: "", body: "  <!DOCTYPE html>", expected: true},
+		{contentType: "application/json", body: `{"key":"value"}`, expected: false},
+		{contentType: "text/plain; charset=utf-8", body: `[1, 2]`, expected: false},
+		{contentType: "text/plain", body: "internal server error", expected: false},
+		{contentType: "", body: "", expected: false},
+	}
+	for _, tt := range tests {
+		if actual := isNonJSONBody(tt.contentType, []byte(tt.body)); actual != tt.expected {
+			t.Errorf("isNonJSONBody(%q, %q) = %v, want %v", tt.contentType, tt.body, actual, tt.expected)
+		}
+	}
+}
+
+func TestUnhealthyEndpointError(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   EndpointResult
+		expected string
+	}{
+		{
+			name:     "without reasons",
+			expected: "endpoint core_api is unhealthy",
+		},
+		{
+			name: "errors and failed conditions",
+			result: EndpointResult{
+				Errors: []string{"timeout", "connection reset"},
+				ConditionResults: []ConditionResult{
+					{Condition: "[CONNECTED] == true", Success: true},
+					{Condition: "[STATUS] (503) == 200"},
+				},
+			},
+			expected: "endpoint core_api is unhealthy: timeout; connection reset; condition [STATUS] (503) == 200 failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &UnhealthyEndpointError{Key: "core_api", Result: tt.result}
+			if err.Error() != tt.expected {
+				t.Errorf("got %q, want %q", err.Error(), tt.expected)
+			}
+			if !errors.Is(err, ErrUnhealthy) {
+				t.Error("expected error to match ErrUnhealthy")
+			}
+		})
+	}
+}
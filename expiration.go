@@ -0,0 +1,198 @@
+package gatussdk
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// CertificateExpirationPlaceholder is the placeholder used in conditions on the TLS certificate expiration.
+	CertificateExpirationPlaceholder = "[CERTIFICATE_EXPIRATION]"
+	// DomainExpirationPlaceholder is the placeholder used in conditions on the domain expiration.
+	DomainExpirationPlaceholder = "[DOMAIN_EXPIRATION]"
+)
+
+// resolvedPlaceholderPattern matches a placeholder followed by its resolved value in parentheses,
+// as displayed by Gatus in condition results (e.g. "[CERTIFICATE_EXPIRATION] (172800000) > 48h").
+var resolvedPlaceholderPattern = regexp.MustCompile(`(\[[A-Z_]+\])\s*\(([^)]*)\)`)
+
+// CertificateExpiresIn returns the time left until the TLS certificate of the endpoint expires,
+// and whether that information is available.
+//
+// The value is taken from CertificateExpiration if set, and otherwise recovered from the resolved value of
+// a [CERTIFICATE_EXPIRATION] condition. Gatus only includes resolved values in the condition results of
+// some checks (typically failed ones), so the information may not be available for every result.
+//
+// Example:
+//
+//	if expiresIn, ok := result.CertificateExpiresIn(); ok && expiresIn < 7*24*time.Hour {
+//	    fmt.Printf("certificate expires in %s\n", expiresIn)
+//	}
+func (r *EndpointResult) CertificateExpiresIn() (time.Duration, bool) {
+	if r.CertificateExpiration != 0 {
+		return r.CertificateExpiration, true
+	}
+	return r.resolvedDuration(CertificateExpirationPlaceholder)
+}
+
+// DomainExpiresIn returns the time left until the domain registration of the endpoint expires,
+// and whether that information is available.
+//
+// The value is taken from DomainExpiration if set, and otherwise recovered from the resolved value of
+// a [DOMAIN_EXPIRATION] condition. Gatus only includes resolved values in the condition results of
+// some checks (typically failed ones), so the information may not be available for every result.
+func (r *EndpointResult) DomainExpiresIn() (time.Duration, bool) {
+	if r.DomainExpiration != 0 {
+		return r.DomainExpiration, true
+	}
+	return r.resolvedDuration(DomainExpirationPlaceholder)
+}
+
+// resolvedDuration returns the resolved value of the given placeholder in the condition results, parsed as a duration.
+func (r *EndpointResult) resolvedDuration(placeholder string) (time.Duration, bool) {
+	for _, conditionResult := range r.ConditionResults {
+		for _, match := range resolvedPlaceholderPattern.FindAllStringSubmatch(conditionResult.Condition, -1) {
+			if match[1] != placeholder {
+				continue
+			}
+			if duration, ok := parseResolvedDuration(match[2]); ok {
+				return duration, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// parseResolvedDuration parses a resolved duration value, which Gatus displays as a number of milliseconds.
+// Go duration strings (e.g. "48h") are also accepted.
+func parseResolvedDuration(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if milliseconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(milliseconds) * time.Millisecond, true
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return duration, true
+	}
+	return 0, false
+}
+
+// Expiry is the time left until something an endpoint depends on, such as its TLS certificate, expires.
+type Expiry struct {
+	// Key is the key of the endpoint.
+	Key string `json:"key"`
+	// Name is the name of the endpoint.
+	Name string `json:"name"`
+	// Group is the group of the endpoint.
+	Group string `json:"group,omitempty"`
+	// ExpiresIn is the time that was left until expiration when the endpoint was checked.
+	ExpiresIn time.Duration `json:"expiresIn"`
+	// CheckedAt is the timestamp of the result ExpiresIn was taken from.
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// ExpiresAt returns the time at which expiration occurs.
+func (e Expiry) ExpiresAt() time.Time {
+	return e.CheckedAt.Add(e.ExpiresIn)
+}
+
+// DaysLeft returns the number of whole days left until expiration at the time the endpoint was checked.
+// It is negative if expiration had already occurred.
+func (e Expiry) DaysLeft() int {
+	days := int(e.ExpiresIn / (24 * time.Hour))
+	if e.ExpiresIn < 0 && e.ExpiresIn%(24*time.Hour) != 0 {
+		days--
+	}
+	return days
+}
+
+// CertificateExpiries returns the time left until the TLS certificate of each endpoint expires, soonest first.
+// The information is taken from the most recent result of each endpoint providing it (see CertificateExpiresIn),
+// and endpoints for which it is not available are omitted.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, expiry := range CertificateExpiries(statuses) {
+//	    if expiry.DaysLeft() > 30 {
+//	        break
+//	    }
+//	    fmt.Printf("certificate of %s expires in %d days\n", expiry.Key, expiry.DaysLeft())
+//	}
+func CertificateExpiries(statuses []EndpointStatus) []Expiry {
+	return expiries(statuses, (*EndpointResult).CertificateExpiresIn)
+}
+
+// DomainExpiries returns the time left until the domain registration of each endpoint expires, soonest first.
+// The information is taken from the most recent result of each endpoint providing it (see DomainExpiresIn),
+// and endpoints for which it is not available are omitted.
+//
+// Example:
+//
+//	report := NewExpiryReport(DomainExpiries(statuses), 30*24*time.Hour, time.Now())
+//	for _, expiry := range report.ExpiringSoon {
+//	    fmt.Printf("renew %s before %s\n", expiry.Name, expiry.ExpiresAt().Format(time.DateOnly))
+//	}
+func DomainExpiries(statuses []EndpointStatus) []Expiry {
+	return expiries(statuses, (*EndpointResult).DomainExpiresIn)
+}
+
+// ExpiryReport classifies expiries by urgency, e.g. to track certificate rotations or domain renewals.
+// Each list is sorted soonest first.
+type ExpiryReport struct {
+	// Expired contains the expiries that occurred at or before the time of the report.
+	Expired []Expiry `json:"expired"`
+	// ExpiringSoon contains the expiries occurring within the threshold of the report.
+	ExpiringSoon []Expiry `json:"expiringSoon"`
+	// Valid contains the expiries occurring after the threshold of the report.
+	Valid []Expiry `json:"valid"`
+}
+
+// NewExpiryReport classifies expiries relative to now: expiries occurring within threshold of now are
+// expiring soon. The expiries are expected to be sorted soonest first, as returned by CertificateExpiries
+// and DomainExpiries.
+func NewExpiryReport(expiries []Expiry, threshold time.Duration, now time.Time) ExpiryReport {
+	report := ExpiryReport{Expired: []Expiry{}, ExpiringSoon: []Expiry{}, Valid: []Expiry{}}
+	for _, expiry := range expiries {
+		switch expiresAt := expiry.ExpiresAt(); {
+		case !expiresAt.After(now):
+			report.Expired = append(report.Expired, expiry)
+		case !expiresAt.After(now.Add(threshold)):
+			report.ExpiringSoon = append(report.ExpiringSoon, expiry)
+		default:
+			report.Valid = append(report.Valid, expiry)
+		}
+	}
+	return report
+}
+
+// expiries returns the expiry reported by expiresIn for the most recent result of each endpoint providing it,
+// sorted soonest first. Endpoints expiring at the same time are sorted by key.
+func expiries(statuses []EndpointStatus, expiresIn func(*EndpointResult) (time.Duration, bool)) []Expiry {
+	var expiries []Expiry
+	for _, status := range statuses {
+		for i := len(status.Results) - 1; i >= 0; i-- {
+			result := &status.Results[i]
+			if duration, ok := expiresIn(result); ok {
+				expiries = append(expiries, Expiry{
+					Key:       status.Key,
+					Name:      status.Name,
+					Group:     status.Group,
+					ExpiresIn: duration,
+					CheckedAt: result.Timestamp,
+				})
+				break
+			}
+		}
+	}
+	slices.SortFunc(expiries, func(a, b Expiry) int {
+		return cmp.Or(a.ExpiresAt().Compare(b.ExpiresAt()), cmp.Compare(a.Key, b.Key))
+	})
+	return expiries
+}
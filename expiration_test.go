@@ -0,0 +1,179 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEndpointResult_CertificateExpiresIn(t *testing.T) {
+	tests := []struct {
+		name          string
+		result        EndpointResult
+		expected      time.Duration
+		expectedFound bool
+	}{
+		{
+			name:          "from field",
+			result:        EndpointResult{CertificateExpiration: 72 * time.Hour},
+			expected:      72 * time.Hour,
+			expectedFound: true,
+		},
+		{
+			name: "from resolved condition in milliseconds",
+			result: EndpointResult{ConditionResults: []ConditionResult{
+				{Condition: "[STATUS] == 200", Success: true},
+				{Condition: "[CERTIFICATE_EXPIRATION] (86400000) > 48h", Success: false},
+			}},
+			expected:      24 * time.Hour,
+			expectedFound: true,
+		},
+		{
+			name: "from resolved condition as duration string",
+			result: EndpointResult{ConditionResults: []ConditionResult{
+				{Condition: "[CERTIFICATE_EXPIRATION] (36h) > 48h", Success: false},
+			}},
+			expected:      36 * time.Hour,
+			expectedFound: true,
+		},
+		{
+			name: "unresolved condition",
+			result: EndpointResult{ConditionResults: []ConditionResult{
+				{Condition: "[CERTIFICATE_EXPIRATION] > 48h", Success: true},
+			}},
+			expectedFound: false,
+		},
+		{
+			name: "other placeholder",
+			result: EndpointResult{ConditionResults: []ConditionResult{
+				{Condition: "[DOMAIN_EXPIRATION] (1000) > 720h", Success: false},
+			}},
+			expectedFound: false,
+		},
+		{
+			name:          "no information",
+			result:        EndpointResult{},
+			expectedFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, found := tt.result.CertificateExpiresIn()
+			if found != tt.expectedFound || actual != tt.expected {
+				t.Errorf("CertificateExpiresIn() = (%v, %v), want (%v, %v)", actual, found, tt.expected, tt.expectedFound)
+			}
+		})
+	}
+}
+
+func TestEndpointResult_DomainExpiresIn(t *testing.T) {
+	result := EndpointResult{ConditionResults: []ConditionResult{
+		{Condition: "[DOMAIN_EXPIRATION] (3600000) > 720h", Success: false},
+	}}
+	if actual, found := result.DomainExpiresIn(); !found || actual != time.Hour {
+		t.Errorf("DomainExpiresIn() = (%v, %v), want (1h, true)", actual, found)
+	}
+	result = EndpointResult{DomainExpiration: 48 * time.Hour}
+	if actual, found := result.DomainExpiresIn(); !found || actual != 48*time.Hour {
+		t.Errorf("DomainExpiresIn() = (%v, %v), want (48h, true)", actual, found)
+	}
+}
+
+func TestEndpointResult_Expiration_JSON(t *testing.T) {
+	var result EndpointResult
+	if err := json.Unmarshal([]byte(`{"status":200,"certificateExpiration":3600000000000}`), &result); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if result.CertificateExpiration != time.Hour {
+		t.Errorf("CertificateExpiration = %v, want 1h", result.CertificateExpiration)
+	}
+	data, _ := json.Marshal(EndpointResult{})
+	var fields map[string]interface{}
+	json.Unmarshal(data, &fields)
+	if _, exists := fields["certificateExpiration"]; exists {
+		t.Error("expected certificateExpiration to be omitted when empty")
+	}
+}
+
+func TestCertificateExpiries(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	statuses := []EndpointStatus{
+		{Key: "core_api", Results: []EndpointResult{
+			{Timestamp: now.Add(-time.Hour), CertificateExpiration: 30 * 24 * time.Hour},
+			{Timestamp: now, CertificateExpiration: 10 * 24 * time.Hour},
+		}},
+		{Key: "core_web", Results: []EndpointResult{
+			// The most recent result does not report the expiration
+			{Timestamp: now.Add(-time.Hour), ConditionResults: []ConditionResult{{Condition: "[CERTIFICATE_EXPIRATION] (172800000) > 240h"}}},
+			{Timestamp: now},
+		}},
+		{Key: "core_internal", Results: []EndpointResult{{Timestamp: now}}},
+		{Key: "core_expired", Results: []EndpointResult{{Timestamp: now, CertificateExpiration: -36 * time.Hour}}},
+	}
+	expiries := CertificateExpiries(statuses)
+	if len(expiries) != 3 {
+		t.Fatalf("got %d expiries, want 3: %+v", len(expiries), expiries)
+	}
+	expected := []struct {
+		key       string
+		daysLeft  int
+		expiresAt time.Time
+	}{
+		{key: "core_expired", daysLeft: -2, expiresAt: now.Add(-36 * time.Hour)},
+		{key: "core_web", daysLeft: 2, expiresAt: now.Add(47 * time.Hour)},
+		{key: "core_api", daysLeft: 10, expiresAt: now.Add(10 * 24 * time.Hour)},
+	}
+	for i, expiry := range expiries {
+		if expiry.Key != expected[i].key || expiry.DaysLeft() != expected[i].daysLeft || !expiry.ExpiresAt().Equal(expected[i].expiresAt) {
+			t.Errorf("expiries[%d] = %+v (%d days, at %v), want %+v", i, expiry, expiry.DaysLeft(), expiry.ExpiresAt(), expected[i])
+		}
+	}
+	if CertificateExpiries(nil) != nil {
+		t.Error("expected no expiries")
+	}
+}
+
+func TestDomainExpiries(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	statuses := []EndpointStatus{
+		{Key: "core_shop", Results: []EndpointResult{{Timestamp: now, DomainExpiration: 90 * 24 * time.Hour}}},
+		{Key: "core_blog", Results: []EndpointResult{{Timestamp: now, ConditionResults: []ConditionResult{{Condition: "[DOMAIN_EXPIRATION] (864000000) > 720h"}}}}},
+		{Key: "core_api", Results: []EndpointResult{{Timestamp: now, CertificateExpiration: time.Hour}}},
+	}
+	expiries := DomainExpiries(statuses)
+	if len(expiries) != 2 || expiries[0].Key != "core_blog" || expiries[1].Key != "core_shop" {
+		t.Fatalf("unexpected expiries: %+v", expiries)
+	}
+	if expiries[0].ExpiresIn != 10*24*time.Hour {
+		t.Errorf("ExpiresIn = %v, want 240h", expiries[0].ExpiresIn)
+	}
+}
+
+func TestNewExpiryReport(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiries := []Expiry{
+		{Key: "expired", CheckedAt: now.Add(-48 * time.Hour), ExpiresIn: 24 * time.Hour},
+		{Key: "now", CheckedAt: now, ExpiresIn: 0},
+		{Key: "soon", CheckedAt: now, ExpiresIn: 7 * 24 * time.Hour},
+		{Key: "threshold", CheckedAt: now, ExpiresIn: 30 * 24 * time.Hour},
+		{Key: "valid", CheckedAt: now, ExpiresIn: 31 * 24 * time.Hour},
+	}
+	report := NewExpiryReport(expiries, 30*24*time.Hour, now)
+	keys := func(expiries []Expiry) []string {
+		keys := []string{}
+		for _, expiry := range expiries {
+			keys = append(keys, expiry.Key)
+		}
+		return keys
+	}
+	if got := keys(report.Expired); !reflect.DeepEqual(got, []string{"expired", "now"}) {
+		t.Errorf("Expired = %v", got)
+	}
+	if got := keys(report.ExpiringSoon); !reflect.DeepEqual(got, []string{"soon", "threshold"}) {
+		t.Errorf("ExpiringSoon = %v", got)
+	}
+	if got := keys(report.Valid); !reflect.DeepEqual(got, []string{"valid"}) {
+		t.Errorf("Valid = %v", got)
+	}
+}
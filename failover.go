@@ -0,0 +1,192 @@
+package gatussdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultFailoverCooldown is the default duration during which a replica that failed is tried last
+// when health-aware ordering is enabled.
+const DefaultFailoverCooldown = 30 * time.Second
+
+// FailoverConfig configures a FailoverClient.
+type FailoverConfig struct {
+	// BaseURLs are the base URLs of the replicas, in order of preference. The first one is the primary.
+	BaseURLs []string
+	// StickyPrimary makes every request start with the primary, instead of the replica that last succeeded.
+	StickyPrimary bool
+	// HealthAware moves replicas that failed within Cooldown to the end of the order in which replicas are tried.
+	HealthAware bool
+	// Cooldown is how long a failed replica is deprioritized when HealthAware is set.
+	// If zero, DefaultFailoverCooldown is used.
+	Cooldown time.Duration
+}
+
+// FailoverClient is a Client that transparently retries requests against the next replica
+// when a replica is unreachable.
+//
+// Only connection-level failures, such as refused connections and DNS errors, trigger a failover.
+// Responses from a replica, including error responses, are returned as-is. The client timeout
+// (see WithTimeout) applies to a request as a whole, across all the replicas tried.
+//
+// By default, once a replica fails, subsequent requests start with the replica that last succeeded,
+// so that a dead primary does not slow down every request. Badge URL methods always use the primary.
+type FailoverClient struct {
+	*Client
+	failover *failoverTransport
+}
+
+// NewFailoverClient creates a new FailoverClient with the given configuration.
+// Client options apply to the requests made to every replica, so WithBasePath replaces the path of every replica.
+//
+// Example:
+//
+//	client, err := gatus.NewFailoverClient(gatus.FailoverConfig{
+//	    BaseURLs:    []string{"https://status-1.example.org", "https://status-2.example.org"},
+//	    HealthAware: true,
+//	}, gatus.WithTimeout(10*time.Second))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	statuses, err := client.GetAllEndpointStatuses(context.Background())
+func NewFailoverClient(config FailoverConfig, opts ...ClientOption) (*FailoverClient, error) {
+	if len(config.BaseURLs) == 0 {
+		return nil, &ValidationError{
+			Field:   "baseURLs",
+			Message: "cannot be empty",
+		}
+	}
+	replicas := make([]*url.URL, 0, len(config.BaseURLs))
+	for _, baseURL := range config.BaseURLs {
+		if err := validateBaseURL(baseURL); err != nil {
+			return nil, &ValidationError{
+				Field:   "baseURLs",
+				Message: err.(*ValidationError).Message,
+			}
+		}
+		replica, _ := url.Parse(strings.TrimRight(baseURL, "/"))
+		replicas = append(replicas, replica)
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = DefaultFailoverCooldown
+	}
+	client := NewClient(config.BaseURLs[0], opts...)
+	if effective, err := url.Parse(client.baseURL); err == nil && effective.EscapedPath() != replicas[0].EscapedPath() {
+		// WithBasePath replaced the path of the primary, so it replaces the path of every replica
+		for _, replica := range replicas {
+			replica.Path, replica.RawPath = effective.Path, effective.RawPath
+		}
+	}
+	failover := &failoverTransport{
+		next:         client.httpClient.Transport,
+		replicas:     replicas,
+		config:       config,
+		lastFailures: make([]time.Time, len(replicas)),
+		now:          client.clock.Now,
+	}
+	if failover.next == nil {
+		failover.next = http.DefaultTransport
+	}
+	// Copy the HTTP client so that a client passed with WithHTTPClient is not modified
+	httpClient := *client.httpClient
+	httpClient.Transport = failover
+	client.httpClient = &httpClient
+	return &FailoverClient{Client: client, failover: failover}, nil
+}
+
+// Active returns the base URL of the replica that last served a request successfully,
+// which is the primary until a failover occurs.
+func (c *FailoverClient) Active() string {
+	c.failover.mu.Lock()
+	defer c.failover.mu.Unlock()
+	return c.failover.replicas[c.failover.active].String()
+}
+
+// failoverTransport is an http.RoundTripper sending each request to the first reachable replica.
+type failoverTransport struct {
+	next     http.RoundTripper
+	replicas []*url.URL
+	config   FailoverConfig
+	now      func() time.Time
+
+	mu           sync.Mutex
+	active       int
+	lastFailures []time.Time
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests are built against the primary, so the part of the URL after its path is relative to any replica.
+	// The escaped path is kept alongside, so that escaped characters such as %2F are sent as is.
+	relativePath := strings.TrimPrefix(req.URL.Path, t.replicas[0].Path)
+	relativeRawPath := strings.TrimPrefix(req.URL.EscapedPath(), t.replicas[0].EscapedPath())
+	var errs []any
+	for n, i := range t.order() {
+		replica := t.replicas[i]
+		attempt := req.Clone(req.Context())
+		if n > 0 && req.Body != nil && req.Body != http.NoBody {
+			// The body may have been consumed by the previous attempt
+			if req.GetBody == nil {
+				break
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("rewinding request body: %w", err)
+			}
+			attempt.Body = body
+		}
+		attempt.URL.Scheme = replica.Scheme
+		attempt.URL.Host = replica.Host
+		attempt.URL.Path = replica.Path + relativePath
+		attempt.URL.RawPath = replica.EscapedPath() + relativeRawPath
+		attempt.Host = ""
+		resp, err := t.next.RoundTrip(attempt)
+		if err == nil {
+			t.mu.Lock()
+			t.active = i
+			t.mu.Unlock()
+			return resp, nil
+		}
+		if req.Context().Err() != nil {
+			return nil, err
+		}
+		t.mu.Lock()
+		t.lastFailures[i] = t.now()
+		t.mu.Unlock()
+		errs = append(errs, fmt.Errorf("%s: %w", replica.Host, err))
+	}
+	// Wrap every error so that callers can still classify them, e.g. with IsRetryable
+	format := "all replicas are unreachable: " + strings.TrimSuffix(strings.Repeat("%w; ", len(errs)), "; ")
+	return nil, fmt.Errorf(format, errs...)
+}
+
+// order returns the indexes of the replicas in the order in which they should be tried.
+func (t *failoverTransport) order() []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	start := t.active
+	if t.config.StickyPrimary {
+		start = 0
+	}
+	order := make([]int, 0, len(t.replicas))
+	for i := range t.replicas {
+		order = append(order, (start+i)%len(t.replicas))
+	}
+	if !t.config.HealthAware {
+		return order
+	}
+	var healthy, unhealthy []int
+	now := t.now()
+	for _, i := range order {
+		if !t.lastFailures[i].IsZero() && now.Sub(t.lastFailures[i]) < t.config.Cooldown {
+			unhealthy = append(unhealthy, i)
+		} else {
+			healthy = append(healthy, i)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
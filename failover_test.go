@@ -0,0 +1,192 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newReplica returns a server answering endpoint status requests with its name, and counting requests.
+func newReplica(t *testing.T, name string, requests *int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		json.NewEncoder(w).Encode([]EndpointStatus{{Name: name, Key: r.URL.Path}})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// deadURL returns the URL of a server that is no longer listening.
+func deadURL() string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+func TestFailoverClient(t *testing.T) {
+	var requests int32
+	secondary := newReplica(t, "secondary", &requests)
+	primary := deadURL()
+
+	client, err := NewFailoverClient(FailoverConfig{BaseURLs: []string{primary, secondary.URL + "/status/"}})
+	if err != nil {
+		t.Fatalf("NewFailoverClient() error = %v", err)
+	}
+	if client.Active() != primary {
+		t.Errorf("Active() = %v, want %v", client.Active(), primary)
+	}
+	statuses, err := client.GetAllEndpointStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Name != "secondary" {
+		t.Fatalf("unexpected statuses: %+v", statuses)
+	}
+	if statuses[0].Key != "/status/api/v1/endpoints/statuses" {
+		t.Errorf("path = %v, want the replica's base path to be preserved", statuses[0].Key)
+	}
+	if client.Active() != secondary.URL+"/status" {
+		t.Errorf("Active() = %v, want %v", client.Active(), secondary.URL+"/status")
+	}
+}
+
+func TestFailoverClient_Paths(t *testing.T) {
+	var path atomic.Value
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path.Store(r.URL.EscapedPath())
+		w.Write([]byte(`{"key": "core_a;b"}`))
+	}))
+	defer secondary.Close()
+
+	tests := []struct {
+		name         string
+		baseURLs     []string
+		opts         []ClientOption
+		expectedPath string
+	}{
+		{
+			name:         "escaped key",
+			baseURLs:     []string{deadURL() + "/primary", secondary.URL + "/secondary"},
+			expectedPath: "/secondary/api/v1/endpoints/core_a%3Bb/statuses",
+		},
+		{
+			name:         "base path",
+			baseURLs:     []string{deadURL() + "/primary", secondary.URL + "/secondary"},
+			opts:         []ClientOption{WithBasePath("/status")},
+			expectedPath: "/status/api/v1/endpoints/core_a%3Bb/statuses",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewFailoverClient(FailoverConfig{BaseURLs: tt.baseURLs}, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewFailoverClient() error = %v", err)
+			}
+			if _, err := client.GetEndpointStatusByKey(context.Background(), "core_a;b"); err != nil {
+				t.Fatalf("GetEndpointStatusByKey() error = %v", err)
+			}
+			if path.Load() != tt.expectedPath {
+				t.Errorf("path = %v, want %v", path.Load(), tt.expectedPath)
+			}
+		})
+	}
+}
+
+func TestFailoverClient_StickyPrimary(t *testing.T) {
+	var primaryRequests, secondaryRequests int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&primaryRequests, 1)
+		// Simulate the primary being unreachable on its first request only
+		if atomic.LoadInt32(&primaryRequests) == 1 {
+			hijacker := w.(http.Hijacker)
+			conn, _, _ := hijacker.Hijack()
+			conn.Close()
+			return
+		}
+		json.NewEncoder(w).Encode([]EndpointStatus{{Name: "primary"}})
+	}))
+	defer primary.Close()
+	secondary := newReplica(t, "secondary", &secondaryRequests)
+
+	for _, sticky := range []bool{false, true} {
+		atomic.StoreInt32(&primaryRequests, 0)
+		atomic.StoreInt32(&secondaryRequests, 0)
+		client, err := NewFailoverClient(FailoverConfig{BaseURLs: []string{primary.URL, secondary.URL}, StickyPrimary: sticky})
+		if err != nil {
+			t.Fatalf("NewFailoverClient() error = %v", err)
+		}
+		for i := 0; i < 2; i++ {
+			if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+				t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+			}
+		}
+		expectedPrimaryRequests, expectedSecondaryRequests := int32(1), int32(2)
+		if sticky {
+			expectedPrimaryRequests, expectedSecondaryRequests = 2, 1
+		}
+		if atomic.LoadInt32(&primaryRequests) != expectedPrimaryRequests || atomic.LoadInt32(&secondaryRequests) != expectedSecondaryRequests {
+			t.Errorf("sticky=%v: got %d primary and %d secondary requests, want %d and %d", sticky,
+				primaryRequests, secondaryRequests, expectedPrimaryRequests, expectedSecondaryRequests)
+		}
+	}
+}
+
+func TestFailoverTransport_HealthAwareOrder(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	client, err := NewFailoverClient(FailoverConfig{
+		BaseURLs:      []string{"https://a.example.org", "https://b.example.org", "https://c.example.org"},
+		StickyPrimary: true,
+		HealthAware:   true,
+		Cooldown:      time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("NewFailoverClient() error = %v", err)
+	}
+	transport := client.failover
+	transport.now = func() time.Time { return now }
+	transport.lastFailures[0] = now.Add(-10 * time.Second)
+	transport.lastFailures[1] = now.Add(-2 * time.Minute)
+	order := transport.order()
+	if len(order) != 3 || order[0] != 1 || order[1] != 2 || order[2] != 0 {
+		t.Errorf("order() = %v, want [1 2 0]", order)
+	}
+}
+
+func TestFailoverClient_AllReplicasUnreachable(t *testing.T) {
+	client, err := NewFailoverClient(FailoverConfig{BaseURLs: []string{deadURL(), deadURL()}})
+	if err != nil {
+		t.Fatalf("NewFailoverClient() error = %v", err)
+	}
+	_, err = client.GetAllEndpointStatuses(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !IsRetryable(err) {
+		t.Errorf("expected connection errors to remain retryable, got %v", err)
+	}
+}
+
+func TestNewFailoverClient_Validation(t *testing.T) {
+	for _, baseURLs := range [][]string{nil, {"not a url"}} {
+		if _, err := NewFailoverClient(FailoverConfig{BaseURLs: baseURLs}); err == nil {
+			t.Errorf("expected error for %v", baseURLs)
+		} else if _, ok := err.(*ValidationError); !ok {
+			t.Errorf("expected ValidationError, got %v", err)
+		}
+	}
+}
+
+func TestNewFailoverClient_DoesNotModifyHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Transport: http.DefaultTransport}
+	if _, err := NewFailoverClient(FailoverConfig{BaseURLs: []string{"https://a.example.org"}}, WithHTTPClient(httpClient)); err != nil {
+		t.Fatalf("NewFailoverClient() error = %v", err)
+	}
+	if httpClient.Transport != http.DefaultTransport {
+		t.Error("expected the provided HTTP client to be left untouched")
+	}
+}
@@ -0,0 +1,91 @@
+package gatussdk
+
+import (
+	"slices"
+	"time"
+)
+
+// StatusFilter reports whether an endpoint status should be kept by FilterStatuses.
+type StatusFilter func(status *EndpointStatus) bool
+
+// FilterStatuses returns the statuses matching every filter, in the order they were given.
+// The statuses are not modified, and the returned slice does not share its backing array with them.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// Endpoints of the core group that failed their most recent check within the last hour
+//	failing := FilterStatuses(statuses, Failing(), InGroup("core"), CheckedSince(time.Now().Add(-time.Hour)))
+func FilterStatuses(statuses []EndpointStatus, filters ...StatusFilter) []EndpointStatus {
+	filtered := make([]EndpointStatus, 0, len(statuses))
+	for i := range statuses {
+		if matchesAll(&statuses[i], filters) {
+			filtered = append(filtered, statuses[i])
+		}
+	}
+	return filtered
+}
+
+func matchesAll(status *EndpointStatus, filters []StatusFilter) bool {
+	for _, filter := range filters {
+		if !filter(status) {
+			return false
+		}
+	}
+	return true
+}
+
+// Failing matches statuses whose most recent result failed.
+func Failing() StatusFilter {
+	return WithSeverity(SeverityDown)
+}
+
+// Healthy matches statuses whose most recent result succeeded and met every condition.
+func Healthy() StatusFilter {
+	return WithSeverity(SeverityHealthy)
+}
+
+// WithSeverity matches statuses whose severity is one of the given severities.
+func WithSeverity(severities ...Severity) StatusFilter {
+	return func(status *EndpointStatus) bool {
+		return slices.Contains(severities, status.Severity())
+	}
+}
+
+// InGroup matches statuses belonging to one of the given groups. Use an empty group to match endpoints without one.
+func InGroup(groups ...string) StatusFilter {
+	return func(status *EndpointStatus) bool {
+		return slices.Contains(groups, status.Group)
+	}
+}
+
+// CheckedSince matches statuses whose most recent result is not older than t.
+// Statuses without results never match.
+func CheckedSince(t time.Time) StatusFilter {
+	return func(status *EndpointStatus) bool {
+		last := lastCheck(status)
+		return !last.IsZero() && !last.Before(t)
+	}
+}
+
+// Not matches statuses that filter does not match.
+func Not(filter StatusFilter) StatusFilter {
+	return func(status *EndpointStatus) bool {
+		return !filter(status)
+	}
+}
+
+// AnyOf matches statuses matched by at least one of the given filters.
+func AnyOf(filters ...StatusFilter) StatusFilter {
+	return func(status *EndpointStatus) bool {
+		for _, filter := range filters {
+			if filter(status) {
+				return true
+			}
+		}
+		return false
+	}
+}
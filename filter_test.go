@@ -0,0 +1,81 @@
+package gatussdk
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFilterStatuses(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	statuses := []EndpointStatus{
+		{Key: "core_api", Group: "core", Results: []EndpointResult{{Success: true, Timestamp: now}}},
+		{Key: "core_web", Group: "core", Results: []EndpointResult{{Success: false, Timestamp: now.Add(-2 * time.Hour)}}},
+		{Key: "auth_login", Group: "auth", Results: []EndpointResult{{Success: false, Timestamp: now}}},
+		{Key: "auth_token", Group: "auth", Results: []EndpointResult{{Success: true, Errors: []string{"slow"}, Timestamp: now}}},
+		{Key: "_new", Name: "new"},
+	}
+	tests := []struct {
+		name     string
+		filters  []StatusFilter
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"core_api", "core_web", "auth_login", "auth_token", "_new"},
+		},
+		{
+			name:     "failing",
+			filters:  []StatusFilter{Failing()},
+			expected: []string{"core_web", "auth_login"},
+		},
+		{
+			name:     "healthy",
+			filters:  []StatusFilter{Healthy()},
+			expected: []string{"core_api"},
+		},
+		{
+			name:     "failing in group",
+			filters:  []StatusFilter{Failing(), InGroup("core")},
+			expected: []string{"core_web"},
+		},
+		{
+			name:     "without group",
+			filters:  []StatusFilter{InGroup("")},
+			expected: []string{"_new"},
+		},
+		{
+			name:     "checked since",
+			filters:  []StatusFilter{CheckedSince(now.Add(-time.Hour))},
+			expected: []string{"core_api", "auth_login", "auth_token"},
+		},
+		{
+			name:     "with severities",
+			filters:  []StatusFilter{WithSeverity(SeverityDegraded, SeverityUnknown)},
+			expected: []string{"auth_token", "_new"},
+		},
+		{
+			name:     "not",
+			filters:  []StatusFilter{Not(InGroup("core", "auth"))},
+			expected: []string{"_new"},
+		},
+		{
+			name:     "any of",
+			filters:  []StatusFilter{AnyOf(Failing(), InGroup(""))},
+			expected: []string{"core_web", "auth_login", "_new"},
+		},
+		{
+			name:     "no match",
+			filters:  []StatusFilter{InGroup("missing")},
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := FilterStatuses(statuses, tt.filters...)
+			if keys := keysOf(filtered); !reflect.DeepEqual(keys, tt.expected) {
+				t.Errorf("got %v, want %v", keys, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package gatussdk
+
+import (
+	"time"
+)
+
+const (
+	// DefaultFlappingMaxTransitions is the default number of transitions between success and failure above which
+	// an endpoint is flapping.
+	DefaultFlappingMaxTransitions = 3
+	// DefaultFlappingWindow is the default period over which transitions are counted.
+	DefaultFlappingWindow = time.Hour
+)
+
+// FlappingOptions configures flapping detection. Zero values use the defaults.
+type FlappingOptions struct {
+	// MaxTransitions is the number of transitions between success and failure within Window above which
+	// results are flapping. Defaults to DefaultFlappingMaxTransitions.
+	MaxTransitions int
+	// Window is the period, ending at the most recent result, over which transitions are counted.
+	// Defaults to DefaultFlappingWindow.
+	Window time.Duration
+}
+
+func (o FlappingOptions) withDefaults() FlappingOptions {
+	if o.MaxTransitions <= 0 {
+		o.MaxTransitions = DefaultFlappingMaxTransitions
+	}
+	if o.Window <= 0 {
+		o.Window = DefaultFlappingWindow
+	}
+	return o
+}
+
+// WithFlappingDetection configures how WatchSuite detects flapping suites (see SuiteEvent.Flapping).
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithFlappingDetection(FlappingOptions{MaxTransitions: 5, Window: 30 * time.Minute}))
+func WithFlappingDetection(options FlappingOptions) ClientOption {
+	return func(c *Client) {
+		c.flapping = options
+	}
+}
+
+// Transitions returns the number of times consecutive results switched between success and failure within
+// window of the most recent result. Results are expected in chronological order, as returned by Gatus.
+func Transitions(results []EndpointResult, window time.Duration) int {
+	return countTransitions(len(results), window, func(i int) (time.Time, bool) {
+		return results[i].Timestamp, results[i].Success
+	})
+}
+
+// IsFlapping reports whether the results oscillate between success and failure more often than allowed
+// by the options, in which case alerting on each transition would cause an alert storm.
+//
+// Example:
+//
+//	if IsFlapping(status.Results, FlappingOptions{}) {
+//	    fmt.Printf("%s is FLAPPING\n", status.Key)
+//	}
+func IsFlapping(results []EndpointResult, options FlappingOptions) bool {
+	options = options.withDefaults()
+	return Transitions(results, options.Window) > options.MaxTransitions
+}
+
+// Flapping matches statuses whose results are flapping (see IsFlapping).
+func Flapping(options FlappingOptions) StatusFilter {
+	return func(status *EndpointStatus) bool {
+		return IsFlapping(status.Results, options)
+	}
+}
+
+// isSuiteFlapping reports whether the suite results are flapping (see IsFlapping).
+func isSuiteFlapping(results []SuiteResult, options FlappingOptions) bool {
+	options = options.withDefaults()
+	transitions := countTransitions(len(results), options.Window, func(i int) (time.Time, bool) {
+		return results[i].Timestamp, results[i].Success
+	})
+	return transitions > options.MaxTransitions
+}
+
+// countTransitions counts the changes in success between consecutive results among the n results returned by
+// result that are within window of the last one.
+func countTransitions(n int, window time.Duration, result func(i int) (time.Time, bool)) int {
+	if n == 0 {
+		return 0
+	}
+	latest, _ := result(n - 1)
+	start := latest.Add(-window)
+	transitions := 0
+	var previous bool
+	first := true
+	for i := 0; i < n; i++ {
+		timestamp, success := result(i)
+		if timestamp.Before(start) {
+			continue
+		}
+		if !first && success != previous {
+			transitions++
+		}
+		previous, first = success, false
+	}
+	return transitions
+}
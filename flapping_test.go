@@ -0,0 +1,54 @@
+package gatussdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsFlapping(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	// results creates results ten minutes apart, ending at now, from a pattern of successes (+) and failures (-)
+	results := func(pattern string) []EndpointResult {
+		results := make([]EndpointResult, len(pattern))
+		for i, c := range pattern {
+			results[i] = EndpointResult{Success: c == '+', Timestamp: now.Add(-time.Duration(len(pattern)-1-i) * 10 * time.Minute)}
+		}
+		return results
+	}
+	tests := []struct {
+		name                string
+		pattern             string
+		options             FlappingOptions
+		expectedTransitions int
+		expected            bool
+	}{
+		{name: "empty", pattern: "", expectedTransitions: 0, expected: false},
+		{name: "stable", pattern: "+++++", expectedTransitions: 0, expected: false},
+		{name: "single outage", pattern: "++--++", expectedTransitions: 2, expected: false},
+		{name: "at the limit", pattern: "+-+-", expectedTransitions: 3, expected: false},
+		{name: "flapping", pattern: "+-+-+", expectedTransitions: 4, expected: true},
+		{name: "custom limit", pattern: "+-+-", options: FlappingOptions{MaxTransitions: 2}, expectedTransitions: 3, expected: true},
+		// Only the last 7 results are within an hour of the most recent one
+		{name: "outside window", pattern: "+-+-+-+++++++", expectedTransitions: 0, expected: false},
+		{name: "custom window", pattern: "+-+-+-+++++++", options: FlappingOptions{Window: 3 * time.Hour}, expectedTransitions: 6, expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window := tt.options.withDefaults().Window
+			if transitions := Transitions(results(tt.pattern), window); transitions != tt.expectedTransitions {
+				t.Errorf("Transitions() = %d, want %d", transitions, tt.expectedTransitions)
+			}
+			if flapping := IsFlapping(results(tt.pattern), tt.options); flapping != tt.expected {
+				t.Errorf("IsFlapping() = %v, want %v", flapping, tt.expected)
+			}
+		})
+	}
+
+	statuses := []EndpointStatus{
+		{Key: "core_stable", Results: results("+++++")},
+		{Key: "core_flapping", Results: results("-+-+-")},
+	}
+	if flapping := FilterStatuses(statuses, Flapping(FlappingOptions{})); len(flapping) != 1 || flapping[0].Key != "core_flapping" {
+		t.Errorf("unexpected flapping statuses: %+v", flapping)
+	}
+}
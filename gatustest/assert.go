@@ -0,0 +1,142 @@
+package gatustest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// ExpectedRequest describes a request the SDK is expected to send.
+// Zero-valued fields are not checked.
+type ExpectedRequest struct {
+	// Method is the expected HTTP method.
+	Method string
+	// Path is the expected URL path (e.g. "/api/v1/endpoints/core_api/statuses").
+	Path string
+	// Query contains the expected query parameters. Only the listed parameters are checked.
+	Query map[string]string
+	// Token is the expected bearer token in the Authorization header.
+	Token string
+	// UserAgent is the expected User-Agent header.
+	UserAgent string
+}
+
+// AssertRequest reports an error on t for every way r differs from expected.
+// It also checks the headers the SDK always sends (see AssertSDKHeaders).
+//
+// Example:
+//
+//	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//	    gatustest.AssertRequest(t, r, gatustest.ExpectedRequest{
+//	        Method: http.MethodPost,
+//	        Path:   "/api/v1/endpoints/core_worker/external",
+//	        Query:  map[string]string{"success": "true"},
+//	        Token:  "secret",
+//	    })
+//	}))
+func AssertRequest(t testing.TB, r *http.Request, expected ExpectedRequest) {
+	t.Helper()
+	if expected.Method != "" {
+		AssertMethod(t, r, expected.Method)
+	}
+	if expected.Path != "" {
+		AssertPath(t, r, expected.Path)
+	}
+	for key, value := range expected.Query {
+		AssertQueryParam(t, r, key, value)
+	}
+	if expected.Token != "" {
+		AssertBearerToken(t, r, expected.Token)
+	}
+	if expected.UserAgent != "" {
+		AssertHeader(t, r, "User-Agent", expected.UserAgent)
+	}
+	AssertSDKHeaders(t, r)
+}
+
+// AssertMethod reports an error on t if r does not use the given method.
+func AssertMethod(t testing.TB, r *http.Request, method string) {
+	t.Helper()
+	if r.Method != method {
+		t.Errorf("Method = %v, want %v", r.Method, method)
+	}
+}
+
+// AssertPath reports an error on t if the URL path of r is not the given path.
+func AssertPath(t testing.TB, r *http.Request, path string) {
+	t.Helper()
+	if r.URL.Path != path {
+		t.Errorf("Path = %v, want %v", r.URL.Path, path)
+	}
+}
+
+// AssertQueryParam reports an error on t if the query parameter key of r is not set to value.
+func AssertQueryParam(t testing.TB, r *http.Request, key, value string) {
+	t.Helper()
+	query := r.URL.Query()
+	if !query.Has(key) {
+		t.Errorf("query parameter %s is missing, want %v", key, value)
+		return
+	}
+	if actual := query.Get(key); actual != value {
+		t.Errorf("query parameter %s = %v, want %v", key, actual, value)
+	}
+}
+
+// AssertBearerToken reports an error on t if r does not carry the given bearer token.
+func AssertBearerToken(t testing.TB, r *http.Request, token string) {
+	t.Helper()
+	AssertHeader(t, r, "Authorization", "Bearer "+token)
+}
+
+// AssertNoAuthorization reports an error on t if r carries an Authorization header.
+func AssertNoAuthorization(t testing.TB, r *http.Request) {
+	t.Helper()
+	if r.Header.Get("Authorization") != "" {
+		t.Error("Authorization header is set, want none")
+	}
+}
+
+// AssertHeader reports an error on t if the header key of r is not set to value.
+func AssertHeader(t testing.TB, r *http.Request, key, value string) {
+	t.Helper()
+	if actual := r.Header.Get(key); actual != value {
+		t.Errorf("%s = %v, want %v", key, actual, value)
+	}
+}
+
+// AssertSDKHeaders reports an error on t if r is missing any of the headers the SDK sends with every request:
+// a User-Agent, "Accept: application/json" and an Accept-Encoding listing gzip.
+func AssertSDKHeaders(t testing.TB, r *http.Request) {
+	t.Helper()
+	if r.Header.Get("User-Agent") == "" {
+		t.Error("User-Agent header is missing")
+	}
+	AssertHeader(t, r, "Accept", "application/json")
+	AssertAcceptEncoding(t, r, "gzip")
+}
+
+// AssertAcceptEncoding reports an error on t if the Accept-Encoding header of r does not list the given encoding.
+func AssertAcceptEncoding(t testing.TB, r *http.Request, encoding string) {
+	t.Helper()
+	for _, accepted := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(accepted) == encoding {
+			return
+		}
+	}
+	t.Errorf("Accept-Encoding = %v, want it to list %v", r.Header.Get("Accept-Encoding"), encoding)
+}
+
+// ExpectRequest wraps next with a handler that asserts every incoming request matches expected.
+// If next is nil, the wrapped handler responds with 200 OK and an empty body.
+func ExpectRequest(t testing.TB, expected ExpectedRequest, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Helper()
+		AssertRequest(t, r, expected)
+		if next == nil {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,85 @@
+package gatustest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gatussdk "github.com/TwiN/gatus-sdk"
+)
+
+// recordingT is a testing.TB that records reported errors instead of failing the test.
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Error(args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprint(args...))
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertRequest(t *testing.T) {
+	var recorder *recordingT
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AssertRequest(recorder, r, ExpectedRequest{
+			Method:    http.MethodPost,
+			Path:      "/api/v1/endpoints/core_worker/external",
+			Query:     map[string]string{"success": "false", "error": "timeout"},
+			Token:     "secret",
+			UserAgent: gatussdk.DefaultUserAgent,
+		})
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := gatussdk.NewClient(server.URL)
+
+	t.Run("matching request", func(t *testing.T) {
+		recorder = &recordingT{TB: t}
+		if err := client.PushExternalEndpointResult(context.Background(), "core_worker", "secret", false, "timeout", ""); err != nil {
+			t.Fatalf("PushExternalEndpointResult() error = %v", err)
+		}
+		if len(recorder.errors) != 0 {
+			t.Errorf("unexpected assertion errors: %v", recorder.errors)
+		}
+	})
+
+	t.Run("mismatching request", func(t *testing.T) {
+		recorder = &recordingT{TB: t}
+		if err := client.PushExternalEndpointResult(context.Background(), "core_other", "wrong", true, "", ""); err != nil {
+			t.Fatalf("PushExternalEndpointResult() error = %v", err)
+		}
+		// path, success, missing error parameter and token
+		if len(recorder.errors) != 4 {
+			t.Errorf("got %d assertion errors, want 4: %v", len(recorder.errors), recorder.errors)
+		}
+	})
+}
+
+func TestExpectRequest(t *testing.T) {
+	recorder := &recordingT{TB: t}
+	server := httptest.NewServer(ExpectRequest(recorder, ExpectedRequest{
+		Method: http.MethodGet,
+		Path:   "/api/v1/endpoints/statuses",
+	}, nil))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/suites/statuses", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+	// path and Accept (Go's transport sets User-Agent and Accept-Encoding by default)
+	if len(recorder.errors) != 2 {
+		t.Errorf("got %d assertion errors, want 2: %v", len(recorder.errors), recorder.errors)
+	}
+	AssertNoAuthorization(t, req)
+}
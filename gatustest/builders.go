@@ -0,0 +1,228 @@
+package gatustest
+
+import (
+	"net/http"
+	"time"
+
+	gatussdk "github.com/TwiN/gatus-sdk"
+)
+
+const (
+	// DefaultResultInterval is the default interval between the timestamps of consecutive results created by builders.
+	DefaultResultInterval = time.Minute
+	// DefaultResultDuration is the default duration of results created by builders.
+	DefaultResultDuration = 150 * time.Millisecond
+)
+
+// NewEndpointResult creates a realistic endpoint result.
+// Successful results have a 200 status and a passing "[STATUS] == 200" condition, while failed results
+// have a 500 status, a failing condition and the given errors.
+// The timestamp is left empty so that builders can assign it; set it explicitly when using the result directly.
+func NewEndpointResult(success bool, errors ...string) gatussdk.EndpointResult {
+	result := gatussdk.EndpointResult{
+		Status:   http.StatusOK,
+		Duration: int64(DefaultResultDuration),
+		Elapsed:  DefaultResultDuration,
+		Success:  success,
+		ConditionResults: []gatussdk.ConditionResult{
+			{Condition: "[STATUS] == 200", Success: success},
+			{Condition: "[RESPONSE_TIME] < 500", Success: true},
+		},
+	}
+	if !success {
+		result.Status = http.StatusInternalServerError
+		result.Errors = errors
+	}
+	return result
+}
+
+// EndpointStatusBuilder builds gatussdk.EndpointStatus values for tests.
+type EndpointStatusBuilder struct {
+	status   gatussdk.EndpointStatus
+	interval time.Duration
+	now      time.Time
+}
+
+// NewEndpointStatus creates a builder for an endpoint status with the given group and name.
+// The key is generated using gatussdk.GenerateKey.
+//
+// Example:
+//
+//	status := gatustest.NewEndpointStatus("core", "api").
+//	    WithSuccessfulResults(5).
+//	    WithFailedResult("connection refused").
+//	    Build()
+func NewEndpointStatus(group, name string) *EndpointStatusBuilder {
+	return &EndpointStatusBuilder{
+		status: gatussdk.EndpointStatus{
+			Name:    name,
+			Group:   group,
+			Key:     gatussdk.GenerateKey(group, name),
+			Results: []gatussdk.EndpointResult{},
+		},
+		interval: DefaultResultInterval,
+	}
+}
+
+// WithResult appends a result. Results are ordered from oldest to newest.
+func (b *EndpointStatusBuilder) WithResult(result gatussdk.EndpointResult) *EndpointStatusBuilder {
+	b.status.Results = append(b.status.Results, result)
+	return b
+}
+
+// WithSuccessfulResults appends n successful results.
+func (b *EndpointStatusBuilder) WithSuccessfulResults(n int) *EndpointStatusBuilder {
+	for i := 0; i < n; i++ {
+		b.WithResult(NewEndpointResult(true))
+	}
+	return b
+}
+
+// WithFailedResult appends a failed result with the given errors.
+func (b *EndpointStatusBuilder) WithFailedResult(errors ...string) *EndpointStatusBuilder {
+	return b.WithResult(NewEndpointResult(false, errors...))
+}
+
+// WithInterval sets the interval between the timestamps of consecutive results.
+func (b *EndpointStatusBuilder) WithInterval(interval time.Duration) *EndpointStatusBuilder {
+	b.interval = interval
+	return b
+}
+
+// WithNow sets the timestamp of the most recent result. Defaults to the time Build is called.
+func (b *EndpointStatusBuilder) WithNow(now time.Time) *EndpointStatusBuilder {
+	b.now = now
+	return b
+}
+
+// Build returns the endpoint status.
+// Results without a timestamp are assigned one so that the newest result is at the current time
+// and each older result is one interval earlier.
+func (b *EndpointStatusBuilder) Build() gatussdk.EndpointStatus {
+	status := b.status
+	status.Results = append([]gatussdk.EndpointResult{}, b.status.Results...)
+	assignTimestamps(len(status.Results), b.now, b.interval, func(i int, timestamp time.Time) {
+		if status.Results[i].Timestamp.IsZero() {
+			status.Results[i].Timestamp = timestamp
+		}
+	})
+	return status
+}
+
+// SuiteStatusBuilder builds gatussdk.SuiteStatus values for tests.
+type SuiteStatusBuilder struct {
+	status   gatussdk.SuiteStatus
+	interval time.Duration
+	now      time.Time
+}
+
+// NewSuiteStatus creates a builder for a suite status with the given group and name.
+// The key is generated using gatussdk.GenerateSuiteKey.
+//
+// Example:
+//
+//	status := gatustest.NewSuiteStatus("", "check-authentication").
+//	    WithSuccessfulExecution("login", "get-profile").
+//	    WithFailedExecution("get-profile", "login", "get-profile").
+//	    Build()
+func NewSuiteStatus(group, name string) *SuiteStatusBuilder {
+	return &SuiteStatusBuilder{
+		status: gatussdk.SuiteStatus{
+			Name:    name,
+			Group:   group,
+			Key:     gatussdk.GenerateSuiteKey(group, name),
+			Results: []gatussdk.SuiteResult{},
+		},
+		interval: DefaultResultInterval,
+	}
+}
+
+// WithResult appends a suite result. Results are ordered from oldest to newest.
+func (b *SuiteStatusBuilder) WithResult(result gatussdk.SuiteResult) *SuiteStatusBuilder {
+	b.status.Results = append(b.status.Results, result)
+	return b
+}
+
+// WithSuccessfulExecution appends a successful suite execution in which every step succeeded.
+func (b *SuiteStatusBuilder) WithSuccessfulExecution(steps ...string) *SuiteStatusBuilder {
+	return b.WithResult(b.newSuiteResult("", steps))
+}
+
+// WithFailedExecution appends a failed suite execution in which failedStep failed.
+// Steps after failedStep are not executed, mirroring how Gatus stops a suite on failure.
+func (b *SuiteStatusBuilder) WithFailedExecution(failedStep string, steps ...string) *SuiteStatusBuilder {
+	return b.WithResult(b.newSuiteResult(failedStep, steps))
+}
+
+// WithInterval sets the interval between the timestamps of consecutive results.
+func (b *SuiteStatusBuilder) WithInterval(interval time.Duration) *SuiteStatusBuilder {
+	b.interval = interval
+	return b
+}
+
+// WithNow sets the timestamp of the most recent result. Defaults to the time Build is called.
+func (b *SuiteStatusBuilder) WithNow(now time.Time) *SuiteStatusBuilder {
+	b.now = now
+	return b
+}
+
+// Build returns the suite status.
+// Results without a timestamp are assigned one so that the newest result is at the current time
+// and each older result is one interval earlier. Endpoint results within an execution inherit
+// timestamps offset by the duration of the previous steps.
+func (b *SuiteStatusBuilder) Build() gatussdk.SuiteStatus {
+	status := b.status
+	status.Results = make([]gatussdk.SuiteResult, len(b.status.Results))
+	for i, result := range b.status.Results {
+		result.EndpointResults = append([]gatussdk.EndpointResult{}, result.EndpointResults...)
+		status.Results[i] = result
+	}
+	assignTimestamps(len(status.Results), b.now, b.interval, func(i int, timestamp time.Time) {
+		result := &status.Results[i]
+		if result.Timestamp.IsZero() {
+			result.Timestamp = timestamp
+		}
+		offset := result.Timestamp
+		for j := range result.EndpointResults {
+			if result.EndpointResults[j].Timestamp.IsZero() {
+				result.EndpointResults[j].Timestamp = offset
+			}
+			offset = offset.Add(time.Duration(result.EndpointResults[j].Duration))
+		}
+	})
+	return status
+}
+
+func (b *SuiteStatusBuilder) newSuiteResult(failedStep string, steps []string) gatussdk.SuiteResult {
+	result := gatussdk.SuiteResult{
+		Name:            b.status.Name,
+		Success:         true,
+		EndpointResults: []gatussdk.EndpointResult{},
+	}
+	for _, step := range steps {
+		endpointResult := NewEndpointResult(step != failedStep)
+		if step == failedStep {
+			endpointResult.Errors = []string{"condition [STATUS] == 200 failed"}
+		}
+		endpointResult.Name = step
+		result.EndpointResults = append(result.EndpointResults, endpointResult)
+		result.Duration += endpointResult.Duration
+		result.Elapsed += endpointResult.Elapsed
+		if step == failedStep {
+			result.Success = false
+			break
+		}
+	}
+	return result
+}
+
+// assignTimestamps calls assign for each of the n results with a timestamp such that the last result
+// is at now and each previous one is one interval earlier.
+func assignTimestamps(n int, now time.Time, interval time.Duration, assign func(i int, timestamp time.Time)) {
+	if now.IsZero() {
+		now = time.Now().Truncate(time.Second)
+	}
+	for i := 0; i < n; i++ {
+		assign(i, now.Add(-time.Duration(n-1-i)*interval))
+	}
+}
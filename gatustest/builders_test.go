@@ -0,0 +1,91 @@
+package gatustest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewEndpointStatus(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	status := NewEndpointStatus("core", "api").
+		WithSuccessfulResults(2).
+		WithFailedResult("connection refused").
+		WithNow(now).
+		Build()
+
+	if status.Key != "core_api" {
+		t.Errorf("Key = %v, want core_api", status.Key)
+	}
+	if len(status.Results) != 3 {
+		t.Fatalf("got %d results, want 3", len(status.Results))
+	}
+	if !status.Results[0].Success || !status.Results[1].Success || status.Results[2].Success {
+		t.Errorf("unexpected success values: %+v", status.Results)
+	}
+	if status.Results[2].Status != http.StatusInternalServerError {
+		t.Errorf("Status = %d, want 500", status.Results[2].Status)
+	}
+	if len(status.Results[2].Errors) != 1 || status.Results[2].Errors[0] != "connection refused" {
+		t.Errorf("Errors = %v, want [connection refused]", status.Results[2].Errors)
+	}
+	if !status.Results[2].Timestamp.Equal(now) {
+		t.Errorf("newest timestamp = %v, want %v", status.Results[2].Timestamp, now)
+	}
+	if !status.Results[0].Timestamp.Equal(now.Add(-2 * DefaultResultInterval)) {
+		t.Errorf("oldest timestamp = %v, want %v", status.Results[0].Timestamp, now.Add(-2*DefaultResultInterval))
+	}
+}
+
+func TestEndpointStatusBuilder_KeepsExplicitTimestamps(t *testing.T) {
+	timestamp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := NewEndpointResult(true)
+	result.Timestamp = timestamp
+	status := NewEndpointStatus("", "standalone").WithResult(result).Build()
+	if !status.Results[0].Timestamp.Equal(timestamp) {
+		t.Errorf("timestamp = %v, want %v", status.Results[0].Timestamp, timestamp)
+	}
+	if status.Key != "_standalone" {
+		t.Errorf("Key = %v, want _standalone", status.Key)
+	}
+}
+
+func TestNewSuiteStatus(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	builder := NewSuiteStatus("", "check-authentication").
+		WithSuccessfulExecution("login", "get-profile", "logout").
+		WithFailedExecution("get-profile", "login", "get-profile", "logout").
+		WithInterval(5 * time.Minute).
+		WithNow(now)
+	status := builder.Build()
+
+	if status.Key != "_check-authentication" {
+		t.Errorf("Key = %v, want _check-authentication", status.Key)
+	}
+	if len(status.Results) != 2 {
+		t.Fatalf("got %d results, want 2", len(status.Results))
+	}
+	successful, failed := status.Results[0], status.Results[1]
+	if !successful.Success || len(successful.EndpointResults) != 3 {
+		t.Errorf("unexpected successful execution: %+v", successful)
+	}
+	if successful.Duration != 3*int64(DefaultResultDuration) {
+		t.Errorf("Duration = %d, want %d", successful.Duration, 3*int64(DefaultResultDuration))
+	}
+	if failed.Success || len(failed.EndpointResults) != 2 {
+		t.Errorf("unexpected failed execution: %+v", failed)
+	}
+	if failed.EndpointResults[1].Name != "get-profile" || failed.EndpointResults[1].Success {
+		t.Errorf("expected get-profile step to fail: %+v", failed.EndpointResults[1])
+	}
+	if !failed.Timestamp.Equal(now) || !successful.Timestamp.Equal(now.Add(-5*time.Minute)) {
+		t.Errorf("unexpected timestamps: %v, %v", successful.Timestamp, failed.Timestamp)
+	}
+	if !failed.EndpointResults[1].Timestamp.Equal(now.Add(DefaultResultDuration)) {
+		t.Errorf("step timestamp = %v, want %v", failed.EndpointResults[1].Timestamp, now.Add(DefaultResultDuration))
+	}
+	// Building must not mutate the builder's results
+	if !builder.Build().Results[0].EndpointResults[0].Timestamp.Equal(successful.EndpointResults[0].Timestamp) {
+		t.Error("expected Build to be repeatable")
+	}
+}
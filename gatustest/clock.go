@@ -0,0 +1,170 @@
+package gatustest
+
+import (
+	"sync"
+	"time"
+
+	gatussdk "github.com/TwiN/gatus-sdk"
+)
+
+// FakeClock is a gatussdk.Clock whose time only moves when Advance or Set is called, allowing watchers,
+// retry backoff and other time-dependent components of the client to be tested without real sleeps.
+// Timers and tickers fire synchronously when the clock is moved past their deadline.
+// As with the time package, a ticker that is not read in time drops ticks rather than accumulating them.
+//
+// Example:
+//
+//	clock := gatustest.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+//	client := server.Client(gatussdk.WithClock(clock))
+//	events := client.WatchSuite(ctx, "_check-authentication", time.Minute)
+//	first := <-events
+//	server.AddSuite(newStatus)
+//	clock.WaitForTimers(1)     // Wait for the watcher to wait for its next poll
+//	clock.Advance(time.Minute) // Trigger the next poll
+//	second := <-events
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+// NewFakeClock creates a FakeClock set to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	clock := &FakeClock{now: now}
+	clock.cond = sync.NewCond(&clock.mu)
+	return clock
+}
+
+var _ gatussdk.Clock = (*FakeClock)(nil)
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer creates a timer firing once the clock is moved at least d forward.
+// A timer with a duration of zero or less fires immediately.
+func (c *FakeClock) NewTimer(d time.Duration) gatussdk.Timer {
+	return c.addWaiter(d, 0)
+}
+
+// NewTicker creates a ticker firing every time the clock is moved forward by d.
+// It panics if d is not greater than zero, like time.NewTicker.
+func (c *FakeClock) NewTicker(d time.Duration) gatussdk.Ticker {
+	if d <= 0 {
+		panic("gatustest: non-positive interval for FakeClock.NewTicker")
+	}
+	return fakeTicker{c.addWaiter(d, d)}
+}
+
+// Advance moves the clock forward by d, firing the timers and tickers whose deadline is reached.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(c.now.Add(d))
+}
+
+// Set moves the clock to now, firing the timers and tickers whose deadline is reached.
+// Moving the clock backward does not fire anything.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(now)
+}
+
+// Timers returns the number of timers and tickers that are waiting to fire.
+func (c *FakeClock) Timers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// WaitForTimers blocks until at least n timers and tickers are waiting to fire. It is typically called before
+// Advance to make sure that the component under test has reached the point where it waits on the clock.
+func (c *FakeClock) WaitForTimers(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+func (c *FakeClock) setLocked(now time.Time) {
+	c.now = now
+	remaining := c.waiters[:0]
+	for _, waiter := range c.waiters {
+		if waiter.deadline.After(now) {
+			remaining = append(remaining, waiter)
+			continue
+		}
+		waiter.fire(now)
+		if waiter.period > 0 {
+			for !waiter.deadline.After(now) {
+				waiter.deadline = waiter.deadline.Add(waiter.period)
+			}
+			remaining = append(remaining, waiter)
+		}
+	}
+	clear(c.waiters[len(remaining):])
+	c.waiters = remaining
+}
+
+func (c *FakeClock) addWaiter(d, period time.Duration) *fakeWaiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	waiter := &fakeWaiter{clock: c, c: make(chan time.Time, 1), deadline: c.now.Add(d), period: period}
+	if d <= 0 {
+		waiter.fire(c.now)
+		return waiter
+	}
+	c.waiters = append(c.waiters, waiter)
+	c.cond.Broadcast()
+	return waiter
+}
+
+// removeWaiter removes waiter from the waiters of the clock, returning whether it was waiting.
+func (c *FakeClock) removeWaiter(waiter *fakeWaiter) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, w := range c.waiters {
+		if w == waiter {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// fakeWaiter is a timer, or the timer underlying a ticker if period is set.
+type fakeWaiter struct {
+	clock    *FakeClock
+	c        chan time.Time
+	deadline time.Time
+	period   time.Duration
+}
+
+func (w *fakeWaiter) fire(now time.Time) {
+	select {
+	case w.c <- now:
+	default:
+	}
+}
+
+func (w *fakeWaiter) C() <-chan time.Time {
+	return w.c
+}
+
+func (w *fakeWaiter) Stop() bool {
+	return w.clock.removeWaiter(w)
+}
+
+type fakeTicker struct {
+	*fakeWaiter
+}
+
+func (t fakeTicker) Stop() {
+	t.fakeWaiter.Stop()
+}
@@ -0,0 +1,89 @@
+package gatustest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gatussdk "github.com/TwiN/gatus-sdk"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	timer := clock.NewTimer(time.Minute)
+	ticker := clock.NewTicker(10 * time.Second)
+	stopped := clock.NewTimer(time.Second)
+	if !stopped.Stop() || stopped.Stop() {
+		t.Error("expected Stop to return true only the first time")
+	}
+	if clock.Timers() != 2 {
+		t.Errorf("Timers() = %d, want 2", clock.Timers())
+	}
+
+	clock.Advance(30 * time.Second)
+	if !clock.Now().Equal(start.Add(30 * time.Second)) {
+		t.Errorf("Now() = %v, want %v", clock.Now(), start.Add(30*time.Second))
+	}
+	select {
+	case <-timer.C():
+		t.Error("timer fired early")
+	default:
+	}
+	// Ticks that are not read in time are dropped
+	if tick := <-ticker.C(); !tick.Equal(start.Add(30 * time.Second)) {
+		t.Errorf("tick = %v, want %v", tick, start.Add(30*time.Second))
+	}
+	select {
+	case <-ticker.C():
+		t.Error("expected a single pending tick")
+	default:
+	}
+
+	clock.Advance(30 * time.Second)
+	if fired := <-timer.C(); !fired.Equal(start.Add(time.Minute)) {
+		t.Errorf("timer fired at %v, want %v", fired, start.Add(time.Minute))
+	}
+	<-ticker.C()
+	if timer.Stop() {
+		t.Error("expected Stop to return false after the timer fired")
+	}
+	ticker.Stop()
+	if clock.Timers() != 0 {
+		t.Errorf("Timers() = %d, want 0", clock.Timers())
+	}
+
+	select {
+	case <-clock.NewTimer(0).C():
+	default:
+		t.Error("expected a timer without duration to fire immediately")
+	}
+}
+
+func TestFakeClock_WatchSuite(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	server := NewServer()
+	defer server.Close()
+	server.AddSuite(NewSuiteStatus("", "check-authentication").WithNow(now).WithSuccessfulExecution("login").Build())
+	clock := NewFakeClock(now)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := server.Client(gatussdk.WithClock(clock)).WatchSuite(ctx, "_check-authentication", time.Hour)
+	if first := <-events; first.Err != nil || !first.Result.Success {
+		t.Fatalf("unexpected first event: %+v", first)
+	}
+	server.AddSuite(NewSuiteStatus("", "check-authentication").WithNow(now.Add(time.Minute)).
+		WithSuccessfulExecution("login").
+		WithFailedExecution("login", "login").
+		Build())
+	clock.WaitForTimers(1)
+	if server.RequestCount() != 1 {
+		t.Fatalf("expected a single poll before the interval elapsed, got %d", server.RequestCount())
+	}
+	clock.Advance(time.Hour)
+	if second := <-events; !second.SuccessChanged || second.FailedStep != "login" {
+		t.Errorf("unexpected event: %+v", second)
+	}
+}
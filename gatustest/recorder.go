@@ -0,0 +1,208 @@
+package gatustest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// RecorderMode determines whether a Recorder records real responses or replays recorded ones.
+type RecorderMode int
+
+const (
+	// ModeReplay replays previously recorded interactions and never reaches the network.
+	ModeReplay RecorderMode = iota
+	// ModeRecord forwards requests to the real transport and records the interactions.
+	ModeRecord
+)
+
+// ErrInteractionNotFound is returned by a Recorder in replay mode when no recorded interaction matches a request.
+var ErrInteractionNotFound = errors.New("no recorded interaction matches request")
+
+// Interaction is a single recorded request/response pair.
+type Interaction struct {
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Path is the path of the request, including the query string.
+	Path string `json:"path"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Header contains the response headers.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the decompressed response body.
+	Body string `json:"body"`
+}
+
+// Recorder is an http.RoundTripper that records real Gatus responses to a fixture file
+// and replays them in subsequent runs, allowing hermetic tests against real payload shapes.
+//
+// Requests are matched on method and path (including the query string). The host is ignored,
+// so fixtures recorded against one instance can be replayed against any base URL.
+// When the same request was recorded several times, the responses are replayed in order.
+// Responses are recorded decompressed. Only gzip is negotiated when recording, and responses using any other
+// content encoding fail to be recorded.
+//
+// Example:
+//
+//	mode := gatustest.ModeReplay
+//	if os.Getenv("RECORD") != "" {
+//	    mode = gatustest.ModeRecord
+//	}
+//	recorder, err := gatustest.NewRecorder("testdata/statuses.json", mode, nil)
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	defer recorder.Save()
+//	client := gatussdk.NewClient("https://status.example.org", gatussdk.WithHTTPClient(&http.Client{Transport: recorder}))
+type Recorder struct {
+	path      string
+	mode      RecorderMode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     map[int]bool
+}
+
+// NewRecorder creates a new Recorder backed by the fixture file at path.
+// In replay mode, the fixture file is loaded immediately and must exist.
+// If transport is nil, http.DefaultTransport is used when recording.
+func NewRecorder(path string, mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: transport,
+		replayed:  make(map[int]bool),
+	}
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading fixture: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("decoding fixture: %w", err)
+		}
+	}
+	return r, nil
+}
+
+// Interactions returns a copy of the interactions recorded or loaded so far.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+// Save writes the recorded interactions to the fixture file.
+// It is a no-op in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding fixture: %w", err)
+	}
+	if err := os.WriteFile(r.path, data, 0o644); err != nil {
+		return fmt.Errorf("writing fixture: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") != "" {
+		// Only gzip can be decompressed for the fixture, so no other encoding is negotiated
+		req = req.Clone(req.Context())
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var reader io.Reader = resp.Body
+	// Store decompressed bodies so fixtures stay human-readable
+	switch encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))); encoding {
+	case "", "identity":
+	case "gzip":
+		gzReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("creating gzip reader: %w", err)
+		}
+		defer gzReader.Close()
+		reader = gzReader
+	default:
+		return nil, fmt.Errorf("recording response: unsupported content encoding %q", encoding)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	header := resp.Header.Clone()
+	header.Del("Content-Encoding")
+	header.Del("Content-Length")
+	interaction := Interaction{
+		Method:     req.Method,
+		Path:       req.URL.RequestURI(),
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       string(body),
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+	return interaction.response(req), nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	path := req.URL.RequestURI()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.replayed[i] || interaction.Method != req.Method || interaction.Path != path {
+			continue
+		}
+		r.replayed[i] = true
+		return interaction.response(req), nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, path)
+}
+
+// response builds an http.Response from the interaction.
+func (i Interaction) response(req *http.Request) *http.Response {
+	header := i.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(i.Body))),
+		ContentLength: int64(len(i.Body)),
+		Request:       req,
+	}
+}
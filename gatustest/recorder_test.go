@@ -0,0 +1,105 @@
+package gatustest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gatussdk "github.com/TwiN/gatus-sdk"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "fixture.json")
+
+	server := NewServer()
+	server.AddEndpoint(gatussdk.EndpointStatus{Name: "api", Group: "core"})
+	server.SetUptime("core_api", "24h", 99.9)
+
+	recorder, err := NewRecorder(fixture, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	client := gatussdk.NewClient(server.URL(), gatussdk.WithHTTPClient(&http.Client{Transport: recorder}))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if _, err := client.GetEndpointUptime(context.Background(), "core_api", "24h"); err != nil {
+		t.Fatalf("GetEndpointUptime() error = %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if len(recorder.Interactions()) != 2 {
+		t.Errorf("got %d interactions, want 2", len(recorder.Interactions()))
+	}
+	server.Close()
+
+	replayer, err := NewRecorder(fixture, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	client = gatussdk.NewClient("https://unreachable.invalid", gatussdk.WithHTTPClient(&http.Client{Transport: replayer}))
+	statuses, err := client.GetAllEndpointStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Key != "core_api" {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+	uptime, err := client.GetEndpointUptime(context.Background(), "core_api", "24h")
+	if err != nil {
+		t.Fatalf("GetEndpointUptime() error = %v", err)
+	}
+	if uptime != 99.9 {
+		t.Errorf("uptime = %v, want 99.9", uptime)
+	}
+	// Each interaction is only replayed once
+	if _, err := client.GetAllEndpointStatuses(context.Background()); !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("expected ErrInteractionNotFound, got %v", err)
+	}
+}
+
+func TestNewRecorder_MissingFixture(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil); err == nil {
+		t.Error("expected error for missing fixture in replay mode")
+	}
+}
+
+func TestRecorder_RecordContentEncoding(t *testing.T) {
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		if r.URL.Query().Has("br") {
+			// The server ignores the negotiated encodings
+			w.Header().Set("Content-Encoding", "br")
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	recorder, err := NewRecorder(filepath.Join(t.TempDir(), "fixture.json"), ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder() error = %v", err)
+	}
+	client := gatussdk.NewClient(server.URL, gatussdk.WithHTTPClient(&http.Client{Transport: recorder}),
+		gatussdk.WithDecompressor("br", func(r io.Reader) (io.ReadCloser, error) {
+			return io.NopCloser(r), nil
+		}))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if acceptEncoding != "gzip" {
+		t.Errorf("Accept-Encoding = %q, want gzip", acceptEncoding)
+	}
+	if _, err := client.GetAllEndpointStatuses(context.Background(), gatussdk.WithQueryParam("br", "")); err == nil || !strings.Contains(err.Error(), "unsupported content encoding") {
+		t.Errorf("expected an unsupported content encoding error, got %v", err)
+	}
+	if len(recorder.Interactions()) != 1 {
+		t.Errorf("got %d interactions, want 1", len(recorder.Interactions()))
+	}
+}
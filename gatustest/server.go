@@ -0,0 +1,384 @@
+// Package gatustest provides utilities for testing code that uses the Gatus SDK.
+//
+// The main entry point is Server, an in-memory fake Gatus instance that serves
+// programmable responses on the same API paths as a real Gatus instance.
+//
+// Example:
+//
+//	server := gatustest.NewServer()
+//	defer server.Close()
+//	server.AddEndpoint(gatussdk.EndpointStatus{Name: "api", Group: "core", Key: "core_api"})
+//	client := server.Client()
+//	statuses, err := client.GetAllEndpointStatuses(context.Background())
+package gatustest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	gatussdk "github.com/TwiN/gatus-sdk"
+)
+
+// Failure describes a programmed failure returned by the fake server.
+type Failure struct {
+	// StatusCode is the HTTP status code to return.
+	StatusCode int
+	// Body is the raw response body to return.
+	Body string
+}
+
+// PushedResult represents a result pushed to an external endpoint of the fake server.
+type PushedResult struct {
+	// Key is the key of the external endpoint the result was pushed to.
+	Key string
+	// Success is the value of the success query parameter.
+	Success bool
+	// Error is the value of the error query parameter.
+	Error string
+	// Duration is the value of the duration query parameter.
+	Duration string
+}
+
+// Server is an in-memory fake Gatus server.
+//
+// All methods are safe for concurrent use.
+type Server struct {
+	server *httptest.Server
+
+	mu              sync.RWMutex
+	endpoints       []gatussdk.EndpointStatus
+	suites          []gatussdk.SuiteStatus
+	uptimes         map[string]map[string]float64
+	responseTimes   map[string]map[string]gatussdk.ResponseTimeData
+	externalTokens  map[string]string
+	pushedResults   []PushedResult
+	failures        map[string]Failure
+	defaultFailure  *Failure
+	latency         time.Duration
+	requestsHandled int
+}
+
+// NewServer creates and starts a new fake Gatus server.
+// The caller should call Close when finished to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		uptimes:        make(map[string]map[string]float64),
+		responseTimes:  make(map[string]map[string]gatussdk.ResponseTimeData),
+		externalTokens: make(map[string]string),
+		failures:       make(map[string]Failure),
+	}
+	s.server = httptest.NewServer(s.Handler())
+	return s
+}
+
+// URL returns the base URL of the fake server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the fake server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a new gatussdk.Client configured to talk to the fake server.
+func (s *Server) Client(opts ...gatussdk.ClientOption) *gatussdk.Client {
+	return gatussdk.NewClient(s.URL(), opts...)
+}
+
+// AddEndpoint registers an endpoint status. If the key is empty, it is generated from the group and name.
+// Registering an endpoint with a key that already exists replaces it.
+func (s *Server) AddEndpoint(status gatussdk.EndpointStatus) {
+	if status.Key == "" {
+		status.Key = gatussdk.GenerateKey(status.Group, status.Name)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.endpoints {
+		if s.endpoints[i].Key == status.Key {
+			s.endpoints[i] = status
+			return
+		}
+	}
+	s.endpoints = append(s.endpoints, status)
+}
+
+// AddSuite registers a suite status. If the key is empty, it is generated from the group and name.
+// Registering a suite with a key that already exists replaces it.
+func (s *Server) AddSuite(status gatussdk.SuiteStatus) {
+	if status.Key == "" {
+		status.Key = gatussdk.GenerateSuiteKey(status.Group, status.Name)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.suites {
+		if s.suites[i].Key == status.Key {
+			s.suites[i] = status
+			return
+		}
+	}
+	s.suites = append(s.suites, status)
+}
+
+// SetUptime sets the uptime percentage returned for the given endpoint key and duration.
+func (s *Server) SetUptime(key, duration string, uptime float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.uptimes[key] == nil {
+		s.uptimes[key] = make(map[string]float64)
+	}
+	s.uptimes[key][duration] = uptime
+}
+
+// LoadSnapshot registers the endpoints, suites and uptimes of a snapshot, so that tests can run against
+// a frozen copy of the state of a real instance (see gatussdk.Client.TakeSnapshot).
+func (s *Server) LoadSnapshot(snapshot *gatussdk.Snapshot) {
+	for _, status := range snapshot.Endpoints {
+		s.AddEndpoint(status)
+	}
+	for _, status := range snapshot.Suites {
+		s.AddSuite(status)
+	}
+	for key, uptimes := range snapshot.Uptimes {
+		for duration, uptime := range uptimes {
+			s.SetUptime(key, string(duration), uptime)
+		}
+	}
+}
+
+// SetResponseTimes sets the response time statistics returned for the given endpoint key and duration.
+func (s *Server) SetResponseTimes(key, duration string, data gatussdk.ResponseTimeData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.responseTimes[key] == nil {
+		s.responseTimes[key] = make(map[string]gatussdk.ResponseTimeData)
+	}
+	s.responseTimes[key][duration] = data
+}
+
+// AddExternalEndpoint registers an external endpoint that accepts pushed results authenticated with the given token.
+func (s *Server) AddExternalEndpoint(key, token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.externalTokens[key] = token
+}
+
+// PushedResults returns all results pushed to external endpoints, in the order they were received.
+func (s *Server) PushedResults() []PushedResult {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]PushedResult(nil), s.pushedResults...)
+}
+
+// FailPath makes the server respond to requests on the given path with the given status code and body.
+// The path must match exactly (e.g. "/api/v1/endpoints/statuses").
+func (s *Server) FailPath(path string, statusCode int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[path] = Failure{StatusCode: statusCode, Body: body}
+}
+
+// FailAll makes the server respond to every request with the given status code and body.
+func (s *Server) FailAll(statusCode int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.defaultFailure = &Failure{StatusCode: statusCode, Body: body}
+}
+
+// ClearFailures removes all programmed failures.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = make(map[string]Failure)
+	s.defaultFailure = nil
+}
+
+// SetLatency sets an artificial delay applied before responding to each request.
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// RequestCount returns the number of requests handled by the server.
+func (s *Server) RequestCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.requestsHandled
+}
+
+// Handler returns the http.Handler serving the fake Gatus API.
+// This is useful for mounting the fake API on a custom server.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/endpoints/statuses", s.handleGetAllEndpointStatuses)
+	mux.HandleFunc("GET /api/v1/endpoints/{key}/statuses", s.handleGetEndpointStatus)
+	mux.HandleFunc("GET /api/v1/endpoints/{key}/uptimes/{duration}", s.handleGetEndpointUptime)
+	mux.HandleFunc("GET /api/v1/endpoints/{key}/response-times/{duration}", s.handleGetEndpointResponseTimes)
+	mux.HandleFunc("POST /api/v1/endpoints/{key}/external", s.handlePushExternalEndpointResult)
+	mux.HandleFunc("GET /api/v1/suites/statuses", s.handleGetAllSuiteStatuses)
+	mux.HandleFunc("GET /api/v1/suites/{key}/statuses", s.handleGetSuiteStatus)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requestsHandled++
+		latency := s.latency
+		failure, failed := s.failures[r.URL.Path]
+		if !failed && s.defaultFailure != nil {
+			failure, failed = *s.defaultFailure, true
+		}
+		s.mu.Unlock()
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if failed {
+			w.WriteHeader(failure.StatusCode)
+			_, _ = w.Write([]byte(failure.Body))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleGetAllEndpointStatuses(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	statuses := make([]gatussdk.EndpointStatus, 0, len(s.endpoints))
+	for _, status := range s.endpoints {
+		status.Results = paginate(status.Results, r)
+		statuses = append(statuses, status)
+	}
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+func (s *Server) handleGetEndpointStatus(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, status := range s.endpoints {
+		if status.Key == key {
+			status.Results = paginate(status.Results, r)
+			writeJSON(w, http.StatusOK, status)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "not found")
+}
+
+func (s *Server) handleGetEndpointUptime(w http.ResponseWriter, r *http.Request) {
+	key, duration := r.PathValue("key"), r.PathValue("duration")
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	uptime, ok := s.uptimes[key][duration]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, gatussdk.UptimeData{
+		Uptime:    uptime,
+		Duration:  duration,
+		Timestamp: time.Now(),
+	})
+}
+
+func (s *Server) handleGetEndpointResponseTimes(w http.ResponseWriter, r *http.Request) {
+	key, duration := r.PathValue("key"), r.PathValue("duration")
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.responseTimes[key][duration]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, data)
+}
+
+func (s *Server) handlePushExternalEndpointResult(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.externalTokens[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+token {
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+	query := r.URL.Query()
+	s.pushedResults = append(s.pushedResults, PushedResult{
+		Key:      key,
+		Success:  query.Get("success") == "true",
+		Error:    query.Get("error"),
+		Duration: query.Get("duration"),
+	})
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleGetAllSuiteStatuses(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	statuses := make([]gatussdk.SuiteStatus, 0, len(s.suites))
+	for _, status := range s.suites {
+		status.Results = paginate(status.Results, r)
+		statuses = append(statuses, status)
+	}
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+func (s *Server) handleGetSuiteStatus(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, status := range s.suites {
+		if status.Key == key {
+			status.Results = paginate(status.Results, r)
+			writeJSON(w, http.StatusOK, status)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "not found")
+}
+
+// defaultPageSize is the page size used by Gatus when no page size is specified.
+const defaultPageSize = 20
+
+// paginate returns the page of results requested through the page and pageSize query parameters of r,
+// where page 1 contains the most recent results. Results are ordered from oldest to newest, like in Gatus.
+// Like Gatus, the first page of defaultPageSize results is returned if neither parameter is set.
+func paginate[T any](results []T, r *http.Request) []T {
+	query := r.URL.Query()
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(query.Get("pageSize"))
+	if err != nil || pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	end := len(results) - (page-1)*pageSize
+	if end <= 0 {
+		return results[:0]
+	}
+	return results[max(end-pageSize, 0):end]
+}
+
+// writeJSON writes v as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a Gatus-style JSON error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]string{"error": message})
+}
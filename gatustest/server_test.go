@@ -0,0 +1,271 @@
+package gatustest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	gatussdk "github.com/TwiN/gatus-sdk"
+)
+
+func TestServer_Endpoints(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddEndpoint(gatussdk.EndpointStatus{
+		Name:    "api",
+		Group:   "core",
+		Results: []gatussdk.EndpointResult{{Status: 200, Success: true}},
+	})
+	server.AddEndpoint(gatussdk.EndpointStatus{Name: "standalone"})
+	client := server.Client()
+
+	statuses, err := client.GetAllEndpointStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("got %d statuses, want 2", len(statuses))
+	}
+	if statuses[0].Key != "core_api" {
+		t.Errorf("Key = %v, want core_api", statuses[0].Key)
+	}
+	if statuses[1].Key != "_standalone" {
+		t.Errorf("Key = %v, want _standalone", statuses[1].Key)
+	}
+
+	status, err := client.GetEndpointStatus(context.Background(), "core", "api")
+	if err != nil {
+		t.Fatalf("GetEndpointStatus() error = %v", err)
+	}
+	if len(status.Results) != 1 || !status.Results[0].Success {
+		t.Errorf("unexpected results: %+v", status.Results)
+	}
+
+	_, err = client.GetEndpointStatusByKey(context.Background(), "core_missing")
+	var apiErr *gatussdk.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 APIError, got %v", err)
+	}
+}
+
+func TestServer_UptimeAndResponseTimes(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.SetUptime("core_api", "24h", 99.5)
+	server.SetResponseTimes("core_api", "24h", gatussdk.ResponseTimeData{Average: 150, Min: 100, Max: 200})
+	client := server.Client()
+
+	uptime, err := client.GetEndpointUptime(context.Background(), "core_api", "24h")
+	if err != nil {
+		t.Fatalf("GetEndpointUptime() error = %v", err)
+	}
+	if uptime != 99.5 {
+		t.Errorf("uptime = %v, want 99.5", uptime)
+	}
+	responseTimes, err := client.GetEndpointResponseTimes(context.Background(), "core_api", "24h")
+	if err != nil {
+		t.Fatalf("GetEndpointResponseTimes() error = %v", err)
+	}
+	if responseTimes.Average != 150 || responseTimes.Min != 100 || responseTimes.Max != 200 {
+		t.Errorf("unexpected response times: %+v", responseTimes)
+	}
+	if _, err := client.GetEndpointUptime(context.Background(), "core_api", "7d"); err == nil {
+		t.Error("expected error for unregistered duration")
+	}
+}
+
+func TestServer_Suites(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddSuite(gatussdk.SuiteStatus{
+		Name:    "check-authentication",
+		Results: []gatussdk.SuiteResult{{Name: "check-authentication", Success: true}},
+	})
+	client := server.Client()
+
+	statuses, err := client.GetAllSuiteStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllSuiteStatuses() error = %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Key != "_check-authentication" {
+		t.Errorf("unexpected suite statuses: %+v", statuses)
+	}
+	status, err := client.GetSuiteStatus(context.Background(), "", "check-authentication")
+	if err != nil {
+		t.Fatalf("GetSuiteStatus() error = %v", err)
+	}
+	if len(status.Results) != 1 {
+		t.Errorf("got %d results, want 1", len(status.Results))
+	}
+}
+
+func TestServer_SuitePagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	builder := NewSuiteStatus("", "check-authentication")
+	for i := 0; i < 5; i++ {
+		builder.WithResult(gatussdk.SuiteResult{Name: fmt.Sprint(i), Success: true})
+	}
+	server.AddSuite(builder.Build())
+	client := server.Client()
+
+	tests := []struct {
+		page, pageSize int
+		expected       []string
+	}{
+		{page: 0, pageSize: 0, expected: []string{"0", "1", "2", "3", "4"}},
+		{page: 1, pageSize: 2, expected: []string{"3", "4"}},
+		{page: 3, pageSize: 2, expected: []string{"0"}},
+		{page: 4, pageSize: 2, expected: []string{}},
+	}
+	for _, tt := range tests {
+		status, err := client.GetSuiteStatusByKey(context.Background(), "_check-authentication", gatussdk.WithPage(tt.page, tt.pageSize))
+		if err != nil {
+			t.Fatalf("GetSuiteStatusByKey() error = %v", err)
+		}
+		names := []string{}
+		for _, result := range status.Results {
+			names = append(names, result.Name)
+		}
+		if fmt.Sprint(names) != fmt.Sprint(tt.expected) {
+			t.Errorf("page %d of size %d = %v, want %v", tt.page, tt.pageSize, names, tt.expected)
+		}
+	}
+	statuses, err := client.GetAllSuiteStatuses(context.Background(), gatussdk.WithPage(1, 1))
+	if err != nil {
+		t.Fatalf("GetAllSuiteStatuses() error = %v", err)
+	}
+	if len(statuses) != 1 || len(statuses[0].Results) != 1 || statuses[0].Results[0].Name != "4" {
+		t.Errorf("unexpected suite statuses: %+v", statuses)
+	}
+}
+
+func TestServer_DefaultPage(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	builder := NewSuiteStatus("", "check-authentication")
+	for i := 0; i < defaultPageSize+5; i++ {
+		builder.WithResult(gatussdk.SuiteResult{Name: fmt.Sprint(i), Success: true})
+	}
+	server.AddSuite(builder.Build())
+
+	// Like Gatus, only the most recent page is returned without pagination parameters
+	status, err := server.Client().GetSuiteStatusByKey(context.Background(), "_check-authentication")
+	if err != nil {
+		t.Fatalf("GetSuiteStatusByKey() error = %v", err)
+	}
+	if len(status.Results) != defaultPageSize || status.Results[0].Name != "5" {
+		t.Errorf("expected the %d most recent results, got %+v", defaultPageSize, status.Results)
+	}
+}
+
+func TestServer_PushExternalEndpointResult(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddExternalEndpoint("core_worker", "secret")
+	client := server.Client()
+
+	if err := client.PushExternalEndpointResult(context.Background(), "core_worker", "secret", false, "timeout", "10s"); err != nil {
+		t.Fatalf("PushExternalEndpointResult() error = %v", err)
+	}
+	if err := client.PushExternalEndpointResult(context.Background(), "core_worker", "wrong", true, "", ""); err == nil {
+		t.Error("expected error with invalid token")
+	}
+	pushed := server.PushedResults()
+	if len(pushed) != 1 {
+		t.Fatalf("got %d pushed results, want 1", len(pushed))
+	}
+	expected := PushedResult{Key: "core_worker", Success: false, Error: "timeout", Duration: "10s"}
+	if pushed[0] != expected {
+		t.Errorf("pushed = %+v, want %+v", pushed[0], expected)
+	}
+}
+
+func TestServer_Failures(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.FailPath("/api/v1/endpoints/statuses", http.StatusBadGateway, "bad gateway")
+	_, err := client.GetAllEndpointStatuses(context.Background())
+	var apiErr *gatussdk.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected 502 APIError, got %v", err)
+	}
+	if _, err := client.GetAllSuiteStatuses(context.Background()); err != nil {
+		t.Errorf("unexpected error on non-failing path: %v", err)
+	}
+
+	server.FailAll(http.StatusServiceUnavailable, "")
+	if _, err := client.GetAllSuiteStatuses(context.Background()); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 APIError, got %v", err)
+	}
+
+	server.ClearFailures()
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Errorf("unexpected error after ClearFailures: %v", err)
+	}
+	if server.RequestCount() != 4 {
+		t.Errorf("RequestCount() = %d, want 4", server.RequestCount())
+	}
+}
+
+func TestServer_Latency(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.SetLatency(200 * time.Millisecond)
+	client := server.Client()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetAllEndpointStatuses(ctx); err == nil {
+		t.Error("expected timeout error")
+	}
+}
+
+func TestServer_LoadSnapshot(t *testing.T) {
+	source := NewServer()
+	defer source.Close()
+	source.AddEndpoint(NewEndpointStatus("core", "api").WithSuccessfulResults(2).Build())
+	source.AddSuite(NewSuiteStatus("", "login").WithSuccessfulExecution("login").Build())
+	source.SetUptime("core_api", "24h", 99.5)
+
+	snapshot, err := source.Client().TakeSnapshot(context.Background(), gatussdk.Duration24h)
+	if err != nil {
+		t.Fatalf("TakeSnapshot() error = %v", err)
+	}
+	var buffer bytes.Buffer
+	if err := snapshot.Save(&buffer); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := gatussdk.LoadSnapshot(&buffer)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if uptime, ok := loaded.Uptime("core_api", gatussdk.Duration24h); !ok || uptime != 99.5 {
+		t.Errorf("Uptime() = %v, %v, want 99.5, true", uptime, ok)
+	}
+	if !loaded.Timestamp.Equal(snapshot.Timestamp) {
+		t.Errorf("Timestamp = %v, want %v", loaded.Timestamp, snapshot.Timestamp)
+	}
+
+	replica := NewServer()
+	defer replica.Close()
+	replica.LoadSnapshot(loaded)
+	client := replica.Client()
+	status, err := client.GetEndpointStatusByKey(context.Background(), "core_api")
+	if err != nil || len(status.Results) != 2 {
+		t.Errorf("GetEndpointStatusByKey() = %+v, %v", status, err)
+	}
+	suites, err := client.GetAllSuiteStatuses(context.Background())
+	if err != nil || len(suites) != 1 || suites[0].Key != "_login" {
+		t.Errorf("GetAllSuiteStatuses() = %+v, %v", suites, err)
+	}
+	if uptime, err := client.GetEndpointUptimeFor(context.Background(), "core_api", gatussdk.Duration24h); err != nil || uptime != 99.5 {
+		t.Errorf("GetEndpointUptimeFor() = %v, %v, want 99.5", uptime, err)
+	}
+}
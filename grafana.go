@@ -0,0 +1,288 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// GrafanaMetricResponseTime is the metric of the Grafana targets charting the average response time of
+	// an endpoint, in milliseconds.
+	GrafanaMetricResponseTime = "response_time"
+	// GrafanaMetricSuccessRatio is the metric of the Grafana targets charting the ratio of successful results of
+	// an endpoint, between 0 and 1.
+	GrafanaMetricSuccessRatio = "success_ratio"
+)
+
+const (
+	// maxGrafanaDataPoints is the maximum number of data points of a series, used when a query doesn't set
+	// maxDataPoints or sets a higher value.
+	maxGrafanaDataPoints = 10000
+	// maxGrafanaRequestSize is the maximum size of the body of a request, in bytes.
+	maxGrafanaRequestSize = 1 << 20
+)
+
+// grafanaMetrics are the metrics available for each endpoint.
+var grafanaMetrics = []string{GrafanaMetricResponseTime, GrafanaMetricSuccessRatio}
+
+// GrafanaHandler is an http.Handler implementing the contract of the Grafana SimpleJSON datasource, also supported
+// by the JSON and Infinity datasources, backed by a client, so that Grafana can chart Gatus data without a database
+// in between.
+//
+// It serves the following paths:
+//
+//	GET  /             connection test
+//	POST /search       lists the targets, named "{key}:response_time" and "{key}:success_ratio" for each endpoint
+//	POST /query        returns the time series, or tables, of the targets over the range of the dashboard
+//	POST /annotations  returns the incidents (see Incidents) of the endpoint whose key is the annotation query,
+//	                   or of every endpoint if the query is empty
+//
+// Each request retrieves the status of every endpoint once, so the series cover the results returned by Gatus.
+// Series are resampled to the interval requested by Grafana (see TimeSeries.Resample), raised if needed so that
+// they don't exceed the maxDataPoints of the query. Errors retrieving the
+// statuses are not written to the response, as they may contain the URL of Gatus.
+type GrafanaHandler struct {
+	client *Client
+	mux    *http.ServeMux
+}
+
+// NewGrafanaHandler creates a GrafanaHandler retrieving the statuses of endpoints with client.
+//
+// Example:
+//
+//	http.Handle("/grafana/", http.StripPrefix("/grafana", NewGrafanaHandler(client)))
+//	// Datasource URL: http://my-service/grafana
+func NewGrafanaHandler(client *Client) *GrafanaHandler {
+	h := &GrafanaHandler{client: client, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h.mux.HandleFunc("POST /search", h.search)
+	h.mux.HandleFunc("POST /query", h.query)
+	h.mux.HandleFunc("POST /annotations", h.annotations)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *GrafanaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// grafanaRange is the time range of a Grafana request.
+type grafanaRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// grafanaQueryRequest is the body of a query request.
+type grafanaQueryRequest struct {
+	Range         grafanaRange `json:"range"`
+	IntervalMs    int64        `json:"intervalMs"`
+	MaxDataPoints int64        `json:"maxDataPoints"`
+	Targets       []struct {
+		Target string `json:"target"`
+		Type   string `json:"type"`
+	} `json:"targets"`
+}
+
+// grafanaSeries is a time series returned by a query request.
+type grafanaSeries struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+// grafanaTable is a table returned by a query request.
+type grafanaTable struct {
+	Type    string          `json:"type"`
+	Columns []grafanaColumn `json:"columns"`
+	Rows    [][2]float64    `json:"rows"`
+}
+
+// grafanaColumn is a column of a grafanaTable.
+type grafanaColumn struct {
+	Text string `json:"text"`
+	Type string `json:"type"`
+}
+
+// grafanaAnnotationRequest is the body of an annotations request.
+type grafanaAnnotationRequest struct {
+	Range      grafanaRange    `json:"range"`
+	Annotation json.RawMessage `json:"annotation"`
+}
+
+// grafanaAnnotation is an annotation returned by an annotations request.
+type grafanaAnnotation struct {
+	Annotation json.RawMessage `json:"annotation"`
+	Time       int64           `json:"time"`
+	TimeEnd    int64           `json:"timeEnd"`
+	IsRegion   bool            `json:"isRegion"`
+	Title      string          `json:"title"`
+	Text       string          `json:"text"`
+	Tags       []string        `json:"tags"`
+}
+
+// search writes the targets containing the target of the request, if any.
+func (h *GrafanaHandler) search(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Target string `json:"target"`
+	}
+	if !decodeGrafanaRequest(w, r, &request) {
+		return
+	}
+	statuses, ok := h.statuses(w, r)
+	if !ok {
+		return
+	}
+	targets := make([]string, 0, len(statuses)*len(grafanaMetrics))
+	for _, status := range statuses {
+		for _, metric := range grafanaMetrics {
+			if target := status.Key + ":" + metric; strings.Contains(target, request.Target) {
+				targets = append(targets, target)
+			}
+		}
+	}
+	slices.Sort(targets)
+	writeGrafanaResponse(w, targets)
+}
+
+// query writes the series, or tables, of the targets of the request.
+func (h *GrafanaHandler) query(w http.ResponseWriter, r *http.Request) {
+	var request grafanaQueryRequest
+	if !decodeGrafanaRequest(w, r, &request) {
+		return
+	}
+	statuses, ok := h.statuses(w, r)
+	if !ok {
+		return
+	}
+	response := make([]any, 0, len(request.Targets))
+	for _, target := range request.Targets {
+		key, metric, _ := strings.Cut(target.Target, ":")
+		i := slices.IndexFunc(statuses, func(status EndpointStatus) bool { return status.Key == key })
+		if i < 0 || !slices.Contains(grafanaMetrics, metric) {
+			http.Error(w, fmt.Sprintf("unknown target %q", target.Target), http.StatusBadRequest)
+			return
+		}
+		results := FilterResultsBetween(statuses[i].Results, request.Range.From, request.Range.To)
+		series := NewTimeSeries(results)
+		series = series.Resample(grafanaInterval(series, request.IntervalMs, request.MaxDataPoints))
+		datapoints := make([][2]float64, 0, len(series.Samples))
+		for _, sample := range series.Samples {
+			if sample.Count == 0 {
+				continue
+			}
+			value := sample.SuccessRatio()
+			if metric == GrafanaMetricResponseTime {
+				value = float64(sample.ResponseTime) / float64(time.Millisecond)
+			}
+			datapoints = append(datapoints, [2]float64{value, float64(sample.Timestamp.UnixMilli())})
+		}
+		if target.Type != "table" {
+			response = append(response, grafanaSeries{Target: target.Target, Datapoints: datapoints})
+			continue
+		}
+		rows := make([][2]float64, len(datapoints))
+		for i, datapoint := range datapoints {
+			rows[i] = [2]float64{datapoint[1], datapoint[0]}
+		}
+		response = append(response, grafanaTable{
+			Type:    "table",
+			Columns: []grafanaColumn{{Text: "Time", Type: "time"}, {Text: target.Target, Type: "number"}},
+			Rows:    rows,
+		})
+	}
+	writeGrafanaResponse(w, response)
+}
+
+// annotations writes the incidents overlapping the range of the request of the endpoint whose key is the query of
+// the annotation, or of every endpoint if the query is empty.
+func (h *GrafanaHandler) annotations(w http.ResponseWriter, r *http.Request) {
+	var request grafanaAnnotationRequest
+	if !decodeGrafanaRequest(w, r, &request) {
+		return
+	}
+	var annotation struct {
+		Query string `json:"query"`
+	}
+	if len(request.Annotation) > 0 {
+		if err := json.Unmarshal(request.Annotation, &annotation); err != nil {
+			http.Error(w, "invalid annotation", http.StatusBadRequest)
+			return
+		}
+	}
+	statuses, ok := h.statuses(w, r)
+	if !ok {
+		return
+	}
+	annotations := make([]grafanaAnnotation, 0)
+	for _, status := range statuses {
+		if annotation.Query != "" && status.Key != annotation.Query {
+			continue
+		}
+		for _, incident := range Incidents(status.Results, IncidentOptions{}) {
+			if (!request.Range.To.IsZero() && !incident.Start.Before(request.Range.To)) || incident.End.Before(request.Range.From) {
+				continue
+			}
+			title := status.Key + " is down"
+			if incident.Resolved {
+				title = fmt.Sprintf("%s was down for %s", status.Key, incident.Duration)
+			}
+			annotations = append(annotations, grafanaAnnotation{
+				Annotation: request.Annotation,
+				Time:       incident.Start.UnixMilli(),
+				TimeEnd:    incident.End.UnixMilli(),
+				IsRegion:   true,
+				Title:      title,
+				Text:       strings.Join(incident.ErrorSamples, "\n"),
+				Tags:       []string{"gatus", status.Key},
+			})
+		}
+	}
+	writeGrafanaResponse(w, annotations)
+}
+
+// statuses retrieves the status of every endpoint, writing an error response if it fails.
+func (h *GrafanaHandler) statuses(w http.ResponseWriter, r *http.Request) ([]EndpointStatus, bool) {
+	statuses, err := h.client.GetAllEndpointStatuses(r.Context())
+	if err != nil {
+		// The error is not written to the response, as it may contain the URL of Gatus
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return nil, false
+	}
+	return statuses, true
+}
+
+// grafanaInterval returns the interval to resample series to: the interval requested by Grafana, raised if needed
+// so that the resampled series has at most maxDataPoints samples, or maxGrafanaDataPoints if it is not set or higher.
+func grafanaInterval(series *TimeSeries, intervalMs, maxDataPoints int64) time.Duration {
+	if maxDataPoints <= 0 || maxDataPoints > maxGrafanaDataPoints {
+		maxDataPoints = maxGrafanaDataPoints
+	}
+	interval := time.Duration(intervalMs) * time.Millisecond
+	if len(series.Samples) == 0 || (interval <= 0 && int64(len(series.Samples)) <= maxDataPoints) {
+		return interval
+	}
+	span := series.Samples[len(series.Samples)-1].Timestamp.Sub(series.Samples[0].Timestamp)
+	// Samples are aligned on multiples of the interval, which may add a sample at each end of the series
+	return max(interval, span/time.Duration(max(maxDataPoints-2, 1))+1)
+}
+
+// decodeGrafanaRequest decodes the body of r into v, writing an error response if it fails. Bodies larger than
+// maxGrafanaRequestSize are rejected.
+func decodeGrafanaRequest(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGrafanaRequestSize)).Decode(v); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// writeGrafanaResponse writes v as JSON.
+func writeGrafanaResponse(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
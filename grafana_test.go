@@ -0,0 +1,158 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGrafanaHandler(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	results := newTimedResults(start, 100*time.Millisecond, 200*time.Millisecond, 300*time.Millisecond, 400*time.Millisecond)
+	results[1].Success = false
+	results[1].Errors = []string{"timeout"}
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode([]EndpointStatus{
+			{Key: "core_api", Group: "core", Name: "api", Results: results},
+			{Key: "core_db", Group: "core", Name: "db", Results: []EndpointResult{{Success: false, Timestamp: start.Add(time.Hour)}}},
+		})
+	}))
+	defer server.Close()
+	handler := NewGrafanaHandler(NewClient(server.URL))
+	ms := func(minutes int) string {
+		return strconv.FormatInt(start.Add(time.Duration(minutes)*time.Minute).UnixMilli(), 10)
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "connection test",
+			method:       http.MethodGet,
+			path:         "/",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "search",
+			method:       http.MethodPost,
+			path:         "/search",
+			body:         `{"target": ""}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `["core_api:response_time","core_api:success_ratio","core_db:response_time","core_db:success_ratio"]`,
+		},
+		{
+			name:         "search with target",
+			method:       http.MethodPost,
+			path:         "/search",
+			body:         `{"target": "api:"}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `["core_api:response_time","core_api:success_ratio"]`,
+		},
+		{
+			name:         "query",
+			method:       http.MethodPost,
+			path:         "/query",
+			body:         `{"range": {"from": "2025-01-01T12:01:00Z", "to": "2025-01-01T13:00:00Z"}, "targets": [{"target": "core_api:response_time"}, {"target": "core_api:success_ratio", "type": "timeserie"}]}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `[{"target":"core_api:response_time","datapoints":[[200,` + ms(1) + `],[300,` + ms(2) + `],[400,` + ms(3) + `]]},` +
+				`{"target":"core_api:success_ratio","datapoints":[[0,` + ms(1) + `],[1,` + ms(2) + `],[1,` + ms(3) + `]]}]`,
+		},
+		{
+			name:         "query resampled table",
+			method:       http.MethodPost,
+			path:         "/query",
+			body:         `{"range": {"from": "2025-01-01T11:00:00Z", "to": "2025-01-01T13:00:00Z"}, "intervalMs": 120000, "targets": [{"target": "core_api:success_ratio", "type": "table"}]}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `[{"type":"table","columns":[{"text":"Time","type":"time"},{"text":"core_api:success_ratio","type":"number"}],"rows":[[` + ms(0) + `,0.5],[` + ms(2) + `,1]]}]`,
+		},
+		{
+			name:         "query unknown target",
+			method:       http.MethodPost,
+			path:         "/query",
+			body:         `{"targets": [{"target": "core_missing:response_time"}]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "query invalid body",
+			method:       http.MethodPost,
+			path:         "/query",
+			body:         `{`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "annotations",
+			method:       http.MethodPost,
+			path:         "/annotations",
+			body:         `{"range": {"from": "2025-01-01T11:00:00Z", "to": "2025-01-01T14:00:00Z"}, "annotation": {"name": "outages", "query": "core_api"}}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `[{"annotation":{"name":"outages","query":"core_api"},"time":` + ms(1) + `,"timeEnd":` + ms(2) + `,"isRegion":true,"title":"core_api was down for 1m0s","text":"timeout","tags":["gatus","core_api"]}]`,
+		},
+		{
+			name:         "annotations of every endpoint in range",
+			method:       http.MethodPost,
+			path:         "/annotations",
+			body:         `{"range": {"from": "2025-01-01T12:30:00Z", "to": "2025-01-01T14:00:00Z"}, "annotation": {"query": ""}}`,
+			expectedCode: http.StatusOK,
+			expectedBody: `[{"annotation":{"query":""},"time":` + ms(60) + `,"timeEnd":` + ms(60) + `,"isRegion":true,"title":"core_db is down","text":"","tags":["gatus","core_db"]}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+			if recorder.Code != tt.expectedCode {
+				t.Fatalf("expected status code %d, got %d: %s", tt.expectedCode, recorder.Code, recorder.Body.String())
+			}
+			if tt.expectedBody != "" && strings.TrimSpace(recorder.Body.String()) != tt.expectedBody {
+				t.Errorf("got:\n%s\nwant:\n%s", recorder.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+
+	t.Run("query limited to maxDataPoints", func(t *testing.T) {
+		for _, body := range []string{
+			`{"intervalMs": 1, "maxDataPoints": 2, "targets": [{"target": "core_api:response_time"}]}`,
+			`{"intervalMs": 0, "maxDataPoints": 2, "targets": [{"target": "core_api:response_time"}]}`,
+		} {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(body)))
+			var series []grafanaSeries
+			if err := json.Unmarshal(recorder.Body.Bytes(), &series); err != nil {
+				t.Fatalf("expected series, got %d: %s", recorder.Code, recorder.Body.String())
+			}
+			if len(series) != 1 || len(series[0].Datapoints) == 0 || len(series[0].Datapoints) > 2 {
+				t.Errorf("expected at most 2 datapoints, got %+v", series)
+			}
+		}
+	})
+
+	t.Run("request too large", func(t *testing.T) {
+		body := `{"target": "` + strings.Repeat("a", maxGrafanaRequestSize) + `"}`
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/search", strings.NewReader(body)))
+		if recorder.Code != http.StatusBadRequest {
+			t.Errorf("expected status code %d, got %d", http.StatusBadRequest, recorder.Code)
+		}
+	})
+
+	failing = true
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/search", strings.NewReader(`{}`)))
+	if recorder.Code != http.StatusBadGateway || strings.Contains(recorder.Body.String(), server.URL) {
+		t.Errorf("expected 502 without the URL of Gatus, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+}
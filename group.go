@@ -0,0 +1,67 @@
+package gatussdk
+
+import "context"
+
+// GroupHealth counts the endpoints of a group by the outcome of their most recent result.
+type GroupHealth struct {
+	// Total is the number of endpoints in the group.
+	Total int `json:"total"`
+	// Up is the number of endpoints whose most recent result succeeded.
+	Up int `json:"up"`
+	// Down is the number of endpoints whose most recent result failed.
+	Down int `json:"down"`
+	// Unknown is the number of endpoints without results.
+	Unknown int `json:"unknown"`
+}
+
+// State returns the aggregate health of the group, as reported by WatchGroup.
+func (h GroupHealth) State() GroupState {
+	switch {
+	case h.Up+h.Down == 0:
+		return GroupStateUnknown
+	case h.Down == 0:
+		return GroupStateUp
+	case h.Up == 0:
+		return GroupStateDown
+	}
+	return GroupStatePartial
+}
+
+// GetGroupHealth retrieves the status of every endpoint and counts them by group, which is what the header of
+// each group of a status page needs. Endpoints without a group are counted under the empty group.
+//
+// Example:
+//
+//	health, err := client.GetGroupHealth(context.Background())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for group, h := range health {
+//	    fmt.Printf("%s: %s (%d/%d up)\n", group, h.State(), h.Up, h.Total)
+//	}
+func (c *Client) GetGroupHealth(ctx context.Context, opts ...RequestOption) (map[string]GroupHealth, error) {
+	statuses, err := c.GetAllEndpointStatuses(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return GroupHealthOf(statuses), nil
+}
+
+// GroupHealthOf counts statuses by group, like GetGroupHealth, for statuses that were already retrieved.
+func GroupHealthOf(statuses []EndpointStatus) map[string]GroupHealth {
+	health := make(map[string]GroupHealth)
+	for i := range statuses {
+		h := health[statuses[i].Group]
+		h.Total++
+		switch last := statuses[i].LastResult(); {
+		case last == nil:
+			h.Unknown++
+		case last.Success:
+			h.Up++
+		default:
+			h.Down++
+		}
+		health[statuses[i].Group] = h
+	}
+	return health
+}
@@ -0,0 +1,73 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetGroupHealth(t *testing.T) {
+	failing := false
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode([]EndpointStatus{
+			{Key: "core_api", Group: "core", Results: []EndpointResult{{Success: false}, {Success: true}}},
+			{Key: "core_db", Group: "core", Results: []EndpointResult{{Success: true}, {Success: false}}},
+			{Key: "core_new", Group: "core"},
+			{Key: "edge_cdn", Group: "edge", Results: []EndpointResult{{Success: false}}},
+			{Key: "_standalone", Results: []EndpointResult{{Success: true}}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	health, err := client.GetGroupHealth(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]GroupHealth{
+		"core": {Total: 3, Up: 1, Down: 1, Unknown: 1},
+		"edge": {Total: 1, Down: 1},
+		"":     {Total: 1, Up: 1},
+	}
+	if !reflect.DeepEqual(health, expected) {
+		t.Errorf("got %+v, want %+v", health, expected)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	failing = true
+	if _, err := client.GetGroupHealth(context.Background()); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestGroupHealth_State(t *testing.T) {
+	tests := []struct {
+		name     string
+		health   GroupHealth
+		expected GroupState
+	}{
+		{name: "empty", health: GroupHealth{}, expected: GroupStateUnknown},
+		{name: "without results", health: GroupHealth{Total: 2, Unknown: 2}, expected: GroupStateUnknown},
+		{name: "up", health: GroupHealth{Total: 3, Up: 2, Unknown: 1}, expected: GroupStateUp},
+		{name: "partial", health: GroupHealth{Total: 2, Up: 1, Down: 1}, expected: GroupStatePartial},
+		{name: "down", health: GroupHealth{Total: 2, Down: 1, Unknown: 1}, expected: GroupStateDown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := tt.health.State(); actual != tt.expected {
+				t.Errorf("got %s, want %s", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultHealthCacheTTL is the default duration for which HealthHandler caches the statuses of its endpoints.
+const DefaultHealthCacheTTL = 10 * time.Second
+
+// HealthHandlerOptions configures a HealthHandler. Zero values use the defaults.
+type HealthHandlerOptions struct {
+	// TTL is the duration for which the statuses retrieved from Gatus are cached, so that frequent probes don't
+	// reach Gatus. Defaults to DefaultHealthCacheTTL. If negative, statuses are retrieved for every probe.
+	TTL time.Duration
+	// GracePeriod is the duration for which the handler keeps reporting healthy after the endpoints stop being
+	// healthy, or Gatus stops being reachable, so that a single failed check doesn't fail the probe. The grace period
+	// also applies after the handler is created, before the endpoints are first healthy. Defaults to none.
+	GracePeriod time.Duration
+}
+
+// HealthHandler is an http.Handler answering 200 OK if a set of endpoints is healthy according to Gatus, and
+// 503 Service Unavailable otherwise, so that a service can expose the health Gatus observes as a Kubernetes
+// readiness or liveness probe. An endpoint is healthy if its most recent result succeeded; endpoints missing from
+// Gatus or without results are not.
+//
+// The body of the response is a JSON object with whether the endpoints are healthy and the keys of those that
+// aren't, e.g. {"healthy":false,"failing":["core_db"]}. Errors retrieving the statuses are not written to the
+// response, as they may contain the URL of Gatus.
+type HealthHandler struct {
+	client  *Client
+	keys    []string
+	options HealthHandlerOptions
+
+	mu          sync.Mutex
+	failing     []string
+	err         error
+	expires     time.Time
+	lastHealthy time.Time
+}
+
+// healthResponse is the body of the responses of HealthHandler.
+type healthResponse struct {
+	Healthy bool     `json:"healthy"`
+	Failing []string `json:"failing,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// NewHealthHandler creates a HealthHandler reporting the health of the endpoints with the given keys, retrieved with
+// client. If keys is empty, every endpoint must be healthy.
+//
+// Example:
+//
+//	http.Handle("/readyz", NewHealthHandler(client, []string{"core_api", "core_db"}, HealthHandlerOptions{
+//	    TTL:         15 * time.Second,
+//	    GracePeriod: time.Minute,
+//	}))
+func NewHealthHandler(client *Client, keys []string, options HealthHandlerOptions) *HealthHandler {
+	if options.TTL == 0 {
+		options.TTL = DefaultHealthCacheTTL
+	}
+	return &HealthHandler{client: client, keys: keys, options: options, lastHealthy: client.clock.Now()}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	now := h.client.clock.Now()
+	h.mu.Lock()
+	fresh := now.Before(h.expires)
+	h.mu.Unlock()
+	if !fresh {
+		statuses, err := h.client.GetAllEndpointStatuses(r.Context())
+		if r.Context().Err() != nil {
+			return
+		}
+		h.update(now, statuses, err)
+	}
+	h.mu.Lock()
+	response := healthResponse{Failing: h.failing}
+	if h.err != nil {
+		response.Error = "unable to retrieve statuses"
+	}
+	response.Healthy = (h.err == nil && len(h.failing) == 0) || now.Sub(h.lastHealthy) < h.options.GracePeriod
+	h.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	if response.Healthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// update records the endpoints failing among statuses, or err if the statuses couldn't be retrieved.
+func (h *HealthHandler) update(now time.Time, statuses []EndpointStatus, err error) {
+	var failing []string
+	if err == nil {
+		failing = failingKeys(h.keys, statuses)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failing, h.err = failing, err
+	if err == nil && len(failing) == 0 {
+		h.lastHealthy = now
+	}
+	if h.options.TTL > 0 {
+		h.expires = now.Add(h.options.TTL)
+	}
+}
+
+// failingKeys returns the keys, in order, whose most recent result among statuses isn't successful,
+// or the keys of every such status if keys is empty.
+func failingKeys(keys []string, statuses []EndpointStatus) []string {
+	var failing []string
+	if len(keys) == 0 {
+		for i := range statuses {
+			if last := statuses[i].LastResult(); last == nil || !last.Success {
+				failing = append(failing, statuses[i].Key)
+			}
+		}
+		return failing
+	}
+	byKey := make(map[string]*EndpointStatus, len(statuses))
+	for i := range statuses {
+		byKey[statuses[i].Key] = &statuses[i]
+	}
+	for _, key := range keys {
+		if last := byKey[key].LastResult(); last == nil || !last.Success {
+			failing = append(failing, key)
+		}
+	}
+	return failing
+}
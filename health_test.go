@@ -0,0 +1,124 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHealthHandler(t *testing.T) {
+	healthy := []EndpointStatus{
+		{Key: "core_api", Results: []EndpointResult{{Success: true}}},
+		{Key: "core_db", Results: []EndpointResult{{Success: false}, {Success: true}}},
+		{Key: "edge_cdn", Results: []EndpointResult{{Success: false}}},
+	}
+	unhealthy := []EndpointStatus{
+		{Key: "core_api", Results: []EndpointResult{{Success: true}}},
+		{Key: "core_db", Results: []EndpointResult{{Success: true}, {Success: false}}},
+	}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Each step advances the clock, then probes the handler while Gatus responds with statuses, or fails if nil
+	type step struct {
+		advance          time.Duration
+		statuses         []EndpointStatus
+		expectedCode     int
+		expectedFailing  []string
+		expectedRequests int
+	}
+	tests := []struct {
+		name    string
+		keys    []string
+		options HealthHandlerOptions
+		steps   []step
+	}{
+		{
+			name:    "healthy",
+			keys:    []string{"core_api", "core_db"},
+			options: HealthHandlerOptions{TTL: -1},
+			steps: []step{
+				{statuses: healthy, expectedCode: http.StatusOK, expectedRequests: 1},
+				{statuses: unhealthy, expectedCode: http.StatusServiceUnavailable, expectedFailing: []string{"core_db"}, expectedRequests: 2},
+			},
+		},
+		{
+			name:    "missing endpoint",
+			keys:    []string{"core_api", "core_missing"},
+			options: HealthHandlerOptions{TTL: -1},
+			steps: []step{
+				{statuses: healthy, expectedCode: http.StatusServiceUnavailable, expectedFailing: []string{"core_missing"}, expectedRequests: 1},
+			},
+		},
+		{
+			name:    "every endpoint",
+			options: HealthHandlerOptions{TTL: -1},
+			steps: []step{
+				{statuses: healthy, expectedCode: http.StatusServiceUnavailable, expectedFailing: []string{"edge_cdn"}, expectedRequests: 1},
+			},
+		},
+		{
+			name: "cached",
+			keys: []string{"core_db"},
+			steps: []step{
+				{statuses: healthy, expectedCode: http.StatusOK, expectedRequests: 1},
+				{advance: DefaultHealthCacheTTL - time.Second, statuses: unhealthy, expectedCode: http.StatusOK, expectedRequests: 1},
+				{advance: time.Second, statuses: unhealthy, expectedCode: http.StatusServiceUnavailable, expectedFailing: []string{"core_db"}, expectedRequests: 2},
+			},
+		},
+		{
+			name:    "grace period",
+			keys:    []string{"core_db"},
+			options: HealthHandlerOptions{TTL: -1, GracePeriod: time.Minute},
+			steps: []step{
+				// The grace period starts when the handler is created
+				{advance: 30 * time.Second, statuses: unhealthy, expectedCode: http.StatusOK, expectedFailing: []string{"core_db"}, expectedRequests: 1},
+				{advance: 30 * time.Second, statuses: unhealthy, expectedCode: http.StatusServiceUnavailable, expectedFailing: []string{"core_db"}, expectedRequests: 2},
+				{statuses: healthy, expectedCode: http.StatusOK, expectedRequests: 3},
+				{advance: 59 * time.Second, expectedCode: http.StatusOK, expectedRequests: 4},
+				{advance: time.Second, expectedCode: http.StatusServiceUnavailable, expectedRequests: 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var statuses []EndpointStatus
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if statuses == nil {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				json.NewEncoder(w).Encode(statuses)
+			}))
+			defer server.Close()
+
+			clock := &recordingClock{now: start}
+			handler := NewHealthHandler(NewClient(server.URL, WithClock(clock)), tt.keys, tt.options)
+			for i, step := range tt.steps {
+				clock.now = clock.now.Add(step.advance)
+				statuses = step.statuses
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+				if recorder.Code != step.expectedCode {
+					t.Errorf("step %d: expected status code %d, got %d", i, step.expectedCode, recorder.Code)
+				}
+				var response healthResponse
+				if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+					t.Fatalf("step %d: invalid response %q: %v", i, recorder.Body.String(), err)
+				}
+				if response.Healthy != (step.expectedCode == http.StatusOK) || !reflect.DeepEqual(response.Failing, step.expectedFailing) {
+					t.Errorf("step %d: unexpected response %+v", i, response)
+				}
+				if (statuses == nil) != (response.Error != "") {
+					t.Errorf("step %d: expected an error only if statuses couldn't be retrieved, got %q", i, response.Error)
+				}
+				if requests != step.expectedRequests {
+					t.Errorf("step %d: expected %d requests to Gatus, got %d", i, step.expectedRequests, requests)
+				}
+			}
+		})
+	}
+}
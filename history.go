@@ -0,0 +1,232 @@
+package gatussdk
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// HistoryStore is an append-only file storing endpoint results observed over time, one JSON record per line.
+// It allows keeping a history longer than the one retained by Gatus, and running the analysis helpers of the SDK
+// offline on the statuses it returns. Results are only stored once, even if they are observed several times.
+//
+// A HistoryStore is safe for concurrent use, but a file must not be opened by more than one HistoryStore at once.
+type HistoryStore struct {
+	mu   sync.Mutex
+	file *os.File
+	// size is the size of the file, which a failed write is truncated back to.
+	size      int64
+	endpoints map[string]*historyEndpoint
+}
+
+// historyEndpoint holds what a HistoryStore knows about a stored endpoint without reading the file.
+type historyEndpoint struct {
+	name, group string
+	last        time.Time
+}
+
+// historyRecord is a line of a HistoryStore file.
+type historyRecord struct {
+	Key    string         `json:"key"`
+	Name   string         `json:"name"`
+	Group  string         `json:"group,omitempty"`
+	Result EndpointResult `json:"result"`
+}
+
+// OpenHistoryStore opens the history store at path, creating the file if it doesn't exist.
+// A truncated last line, as left behind by an interrupted write, is discarded.
+//
+// Example:
+//
+//	store, err := OpenHistoryStore("history.jsonl")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer store.Close()
+func OpenHistoryStore(path string) (*HistoryStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening history store: %w", err)
+	}
+	store := &HistoryStore{file: file, endpoints: make(map[string]*historyEndpoint)}
+	size, err := store.scan(func(record historyRecord) {
+		store.index(record)
+	})
+	if err == nil {
+		// Drop any truncated last line, so that the next record doesn't get appended to it
+		err = file.Truncate(size)
+		store.size = size
+	}
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// Record stores the results of the given statuses that are more recent than the last result stored for
+// the same endpoint, and returns the number of results stored. The results are written at once: if writing
+// fails, none of them are stored, so that they are stored by the next call instead.
+func (s *HistoryStore) Record(statuses []EndpointStatus) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var buffer bytes.Buffer
+	var records []historyRecord
+	for _, status := range statuses {
+		var last time.Time
+		if endpoint := s.endpoints[status.Key]; endpoint != nil {
+			last = endpoint.last
+		}
+		for _, result := range status.Results {
+			if !result.Timestamp.After(last) {
+				continue
+			}
+			record := historyRecord{Key: status.Key, Name: status.Name, Group: status.Group, Result: result}
+			data, err := json.Marshal(record)
+			if err != nil {
+				return 0, fmt.Errorf("encoding history record: %w", err)
+			}
+			buffer.Write(append(data, '\n'))
+			records = append(records, record)
+		}
+	}
+	if buffer.Len() == 0 {
+		return 0, nil
+	}
+	if _, err := s.file.Write(buffer.Bytes()); err != nil {
+		// Drop any partially written record, so that the next records don't get appended to it
+		_ = s.file.Truncate(s.size)
+		return 0, fmt.Errorf("writing history record: %w", err)
+	}
+	s.size += int64(buffer.Len())
+	for _, record := range records {
+		s.index(record)
+	}
+	return len(records), nil
+}
+
+// Statuses returns the status of every stored endpoint with all of its stored results, ordered from oldest to newest.
+// Endpoints are ordered by the time their first result was stored.
+func (s *HistoryStore) Statuses() ([]EndpointStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var statuses []EndpointStatus
+	indexes := make(map[string]int)
+	_, err := s.scan(func(record historyRecord) {
+		i, ok := indexes[record.Key]
+		if !ok {
+			i = len(statuses)
+			indexes[record.Key] = i
+			statuses = append(statuses, EndpointStatus{Key: record.Key, Name: record.Name, Group: record.Group})
+		}
+		statuses[i].Results = append(statuses[i].Results, record.Result)
+	})
+	return statuses, err
+}
+
+// Endpoint returns the status of the endpoint with the given key with all of its stored results,
+// or nil if no result was stored for it.
+func (s *HistoryStore) Endpoint(key string) (*EndpointStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	endpoint := s.endpoints[key]
+	if endpoint == nil {
+		return nil, nil
+	}
+	status := &EndpointStatus{Key: key, Name: endpoint.name, Group: endpoint.group}
+	_, err := s.scan(func(record historyRecord) {
+		if record.Key == key {
+			status.Results = append(status.Results, record.Result)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Close closes the underlying file.
+func (s *HistoryStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// index records what is known about the endpoint of record.
+func (s *HistoryStore) index(record historyRecord) {
+	endpoint := s.endpoints[record.Key]
+	if endpoint == nil {
+		endpoint = &historyEndpoint{}
+		s.endpoints[record.Key] = endpoint
+	}
+	endpoint.name, endpoint.group = record.Name, record.Group
+	if record.Result.Timestamp.After(endpoint.last) {
+		endpoint.last = record.Result.Timestamp
+	}
+}
+
+// scan calls fn with each record of the file, in order, and returns the size of the file without any truncated
+// last line. It must be called with the mutex held.
+func (s *HistoryStore) scan(fn func(record historyRecord)) (int64, error) {
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("reading history store: %w", err)
+	}
+	reader := bufio.NewReader(s.file)
+	var size int64
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A last line without a newline was truncated by an interrupted write
+			return size, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("reading history store: %w", err)
+		}
+		size += int64(len(data))
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		var record historyRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return 0, fmt.Errorf("decoding history store line %d: %w", line, err)
+		}
+		fn(record)
+	}
+}
+
+// RecordHistory polls the status of every endpoint every interval and stores the results in store, until ctx
+// is done. Polling errors are logged and polling continues, but errors writing to the store are returned.
+// The interval should be short enough for the results of each endpoint not to exceed the window returned by Gatus
+// between two polls, or some results will be missed. If interval is zero or negative, DefaultPollInterval is used.
+//
+// Example:
+//
+//	store, err := OpenHistoryStore("history.jsonl")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer store.Close()
+//	go client.RecordHistory(ctx, store, time.Minute)
+func (c *Client) RecordHistory(ctx context.Context, store *HistoryStore, interval time.Duration, opts ...RequestOption) error {
+	schedule := c.newPollSchedule(interval)
+	for {
+		statuses, err := c.GetAllEndpointStatuses(ctx, opts...)
+		if err == nil {
+			if _, err := store.Record(statuses); err != nil {
+				return err
+			}
+		} else if ctx.Err() == nil {
+			c.logger.WarnContext(ctx, "failed to poll endpoint statuses", "error", err)
+		}
+		if !c.wait(ctx, schedule.jitter(schedule.interval)) {
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHistoryStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := func(minute int, success bool) EndpointResult {
+		return EndpointResult{Timestamp: start.Add(time.Duration(minute) * time.Minute), Success: success}
+	}
+
+	store, err := OpenHistoryStore(path)
+	if err != nil {
+		t.Fatalf("OpenHistoryStore() error = %v", err)
+	}
+	stored, err := store.Record([]EndpointStatus{
+		{Key: "core_api", Name: "api", Group: "core", Results: []EndpointResult{result(0, true), result(1, false)}},
+		{Key: "core_web", Name: "web", Group: "core", Results: []EndpointResult{result(0, true)}},
+	})
+	if err != nil || stored != 3 {
+		t.Fatalf("Record() = %d, %v, want 3", stored, err)
+	}
+	// Results that were already stored are skipped
+	stored, err = store.Record([]EndpointStatus{
+		{Key: "core_api", Name: "api", Group: "core", Results: []EndpointResult{result(1, false), result(2, true)}},
+	})
+	if err != nil || stored != 1 {
+		t.Fatalf("Record() = %d, %v, want 1", stored, err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Simulate a write interrupted by a crash
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString(`{"key":"core_api","res`)
+	file.Close()
+
+	store, err = OpenHistoryStore(path)
+	if err != nil {
+		t.Fatalf("OpenHistoryStore() error = %v", err)
+	}
+	defer store.Close()
+	if stored, err := store.Record([]EndpointStatus{{Key: "core_api", Results: []EndpointResult{result(2, true), result(3, true)}}}); err != nil || stored != 1 {
+		t.Fatalf("Record() after reopening = %d, %v, want 1", stored, err)
+	}
+	statuses, err := store.Statuses()
+	if err != nil {
+		t.Fatalf("Statuses() error = %v", err)
+	}
+	if len(statuses) != 2 || statuses[0].Key != "core_api" || len(statuses[0].Results) != 4 || len(statuses[1].Results) != 1 {
+		t.Fatalf("unexpected statuses: %+v", statuses)
+	}
+	if statuses[0].Group != "core" || !statuses[0].Results[3].Timestamp.Equal(start.Add(3*time.Minute)) {
+		t.Errorf("unexpected status: %+v", statuses[0])
+	}
+	status, err := store.Endpoint("core_web")
+	if err != nil || status == nil || status.Name != "web" || len(status.Results) != 1 {
+		t.Errorf("Endpoint() = %+v, %v", status, err)
+	}
+	if status, err := store.Endpoint("core_missing"); status != nil || err != nil {
+		t.Errorf("Endpoint() = %+v, %v, want nil", status, err)
+	}
+}
+
+func TestHistoryStore_FailedWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	store, err := OpenHistoryStore(path)
+	if err != nil {
+		t.Fatalf("OpenHistoryStore() error = %v", err)
+	}
+	defer store.Close()
+	statuses := []EndpointStatus{{Key: "core_api", Results: newTimedResults(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Second, time.Second)}}
+
+	// Writes fail while the file is read-only
+	writable := store.file
+	store.file, err = os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored, err := store.Record(statuses); err == nil || stored != 0 {
+		t.Fatalf("Record() = %d, %v, want an error", stored, err)
+	}
+	store.file.Close()
+	store.file = writable
+
+	// The results that failed to be written are stored by the next call
+	if stored, err := store.Record(statuses); err != nil || stored != 2 {
+		t.Fatalf("Record() after a failed write = %d, %v, want 2", stored, err)
+	}
+	status, err := store.Endpoint("core_api")
+	if err != nil || status == nil || len(status.Results) != 2 {
+		t.Errorf("Endpoint() = %+v, %v", status, err)
+	}
+}
+
+func TestOpenHistoryStore_Corrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	os.WriteFile(path, []byte("not json\n"), 0o644)
+	if _, err := OpenHistoryStore(path); err == nil {
+		t.Error("expected error for corrupted history store")
+	}
+}
+
+func TestClient_RecordHistory(t *testing.T) {
+	now := time.Now()
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		json.NewEncoder(w).Encode([]EndpointStatus{{Key: "core_api", Results: []EndpointResult{{Timestamp: now.Add(time.Duration(polls) * time.Minute)}}}})
+	}))
+	defer server.Close()
+
+	store, err := OpenHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	if err != nil {
+		t.Fatalf("OpenHistoryStore() error = %v", err)
+	}
+	defer store.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := NewClient(server.URL).RecordHistory(ctx, store, 10*time.Millisecond); err != context.DeadlineExceeded {
+		t.Errorf("RecordHistory() error = %v, want context.DeadlineExceeded", err)
+	}
+	status, err := store.Endpoint("core_api")
+	if err != nil || status == nil || len(status.Results) < 2 {
+		t.Errorf("expected a result per poll, got %+v, %v", status, err)
+	}
+}
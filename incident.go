@@ -0,0 +1,83 @@
+package gatussdk
+
+import (
+	"slices"
+	"time"
+)
+
+// DefaultIncidentMaxErrorSamples is the default maximum number of distinct errors kept as samples of an incident.
+const DefaultIncidentMaxErrorSamples = 5
+
+// Incident is a period during which an endpoint was failing, synthesized from consecutive failing results.
+type Incident struct {
+	// Start is the timestamp of the first failing result.
+	Start time.Time `json:"start"`
+	// End is the timestamp of the successful result that resolved the incident, or of the last failing result
+	// if the incident is not resolved.
+	End time.Time `json:"end"`
+	// Duration is the time between Start and End.
+	Duration time.Duration `json:"duration"`
+	// Failures is the number of failing results during the incident.
+	Failures int `json:"failures"`
+	// ErrorSamples contains distinct errors reported by the failing results, in the order they first occurred.
+	ErrorSamples []string `json:"errorSamples,omitempty"`
+	// Resolved indicates whether a successful result followed the failing results.
+	Resolved bool `json:"resolved"`
+}
+
+// IncidentOptions configures how incidents are synthesized. Zero values use the defaults.
+type IncidentOptions struct {
+	// Tolerance is the duration of recoveries below which two incidents are merged into one, so that a brief
+	// success in the middle of an outage does not split it. Defaults to no merging.
+	Tolerance time.Duration
+	// MaxErrorSamples is the maximum number of distinct errors kept per incident.
+	// Defaults to DefaultIncidentMaxErrorSamples.
+	MaxErrorSamples int
+}
+
+// Incidents groups consecutive failing results into incidents, in chronological order.
+// Results are expected in chronological order, as returned by Gatus.
+//
+// Example:
+//
+//	for _, incident := range Incidents(status.Results, IncidentOptions{Tolerance: 5 * time.Minute}) {
+//	    fmt.Printf("%s: down for %s (%d failures, resolved: %v)\n", incident.Start, incident.Duration, incident.Failures, incident.Resolved)
+//	    for _, sample := range incident.ErrorSamples {
+//	        fmt.Printf("  %s\n", sample)
+//	    }
+//	}
+func Incidents(results []EndpointResult, options IncidentOptions) []Incident {
+	if options.MaxErrorSamples <= 0 {
+		options.MaxErrorSamples = DefaultIncidentMaxErrorSamples
+	}
+	var incidents []Incident
+	var current *Incident
+	for _, result := range results {
+		if result.Success {
+			if current != nil && !current.Resolved {
+				current.Resolved = true
+				current.End = result.Timestamp
+			}
+			continue
+		}
+		if current != nil && current.Resolved && result.Timestamp.Sub(current.End) >= options.Tolerance {
+			current = nil
+		}
+		if current == nil {
+			incidents = append(incidents, Incident{Start: result.Timestamp})
+			current = &incidents[len(incidents)-1]
+		}
+		current.Resolved = false
+		current.End = result.Timestamp
+		current.Failures++
+		for _, err := range result.Errors {
+			if len(current.ErrorSamples) < options.MaxErrorSamples && !slices.Contains(current.ErrorSamples, err) {
+				current.ErrorSamples = append(current.ErrorSamples, err)
+			}
+		}
+	}
+	for i := range incidents {
+		incidents[i].Duration = incidents[i].End.Sub(incidents[i].Start)
+	}
+	return incidents
+}
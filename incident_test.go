@@ -0,0 +1,63 @@
+package gatussdk
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIncidents(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return now.Add(time.Duration(minutes) * time.Minute)
+	}
+	results := []EndpointResult{
+		{Success: true, Timestamp: at(0)},
+		{Success: false, Timestamp: at(1), Errors: []string{"connection refused"}},
+		{Success: false, Timestamp: at(2), Errors: []string{"connection refused", "timeout"}},
+		{Success: true, Timestamp: at(3)},
+		{Success: false, Timestamp: at(5), Errors: []string{"timeout"}},
+		{Success: true, Timestamp: at(6)},
+		{Success: true, Timestamp: at(20)},
+		{Success: false, Timestamp: at(30), Errors: []string{"500"}},
+	}
+	tests := []struct {
+		name     string
+		options  IncidentOptions
+		expected []Incident
+	}{
+		{
+			name: "without tolerance",
+			expected: []Incident{
+				{Start: at(1), End: at(3), Duration: 2 * time.Minute, Failures: 2, ErrorSamples: []string{"connection refused", "timeout"}, Resolved: true},
+				{Start: at(5), End: at(6), Duration: time.Minute, Failures: 1, ErrorSamples: []string{"timeout"}, Resolved: true},
+				{Start: at(30), End: at(30), Failures: 1, ErrorSamples: []string{"500"}},
+			},
+		},
+		{
+			name:    "with tolerance",
+			options: IncidentOptions{Tolerance: 5 * time.Minute},
+			expected: []Incident{
+				{Start: at(1), End: at(6), Duration: 5 * time.Minute, Failures: 3, ErrorSamples: []string{"connection refused", "timeout"}, Resolved: true},
+				{Start: at(30), End: at(30), Failures: 1, ErrorSamples: []string{"500"}},
+			},
+		},
+		{
+			name:    "with max error samples",
+			options: IncidentOptions{Tolerance: time.Hour, MaxErrorSamples: 1},
+			expected: []Incident{
+				{Start: at(1), End: at(30), Duration: 29 * time.Minute, Failures: 4, ErrorSamples: []string{"connection refused"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if incidents := Incidents(results, tt.options); !reflect.DeepEqual(incidents, tt.expected) {
+				t.Errorf("got %+v, want %+v", incidents, tt.expected)
+			}
+		})
+	}
+	if incidents := Incidents(results[:1], IncidentOptions{}); incidents != nil {
+		t.Errorf("expected no incidents, got %+v", incidents)
+	}
+}
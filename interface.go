@@ -0,0 +1,115 @@
+package gatussdk
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ClientInterface describes all operations supported by Client.
+// It can be used to inject a mock implementation in code depending on the SDK.
+//
+// Example:
+//
+//	type Dashboard struct {
+//	    gatus gatussdk.ClientInterface
+//	}
+//
+//	dashboard := &Dashboard{gatus: gatussdk.NewClient("https://status.example.org")}
+type ClientInterface interface {
+	// GetAllEndpointStatuses retrieves the status of all configured endpoints.
+	GetAllEndpointStatuses(ctx context.Context, opts ...RequestOption) ([]EndpointStatus, error)
+	// GetEndpointStatusByKey retrieves the status of a specific endpoint by its key.
+	GetEndpointStatusByKey(ctx context.Context, key string, opts ...RequestOption) (*EndpointStatus, error)
+	// IsEndpointHealthy reports whether the most recent result of an endpoint succeeded.
+	IsEndpointHealthy(ctx context.Context, group, name string, opts ...RequestOption) (bool, error)
+	// WaitForHealthy polls the status of an endpoint until its most recent results are successful.
+	WaitForHealthy(ctx context.Context, key string, options WaitOptions, opts ...RequestOption) (*EndpointStatus, error)
+	// WaitForSuiteSuccess polls the status of a suite until its most recent executions are successful.
+	WaitForSuiteSuccess(ctx context.Context, key string, options WaitOptions, opts ...RequestOption) (*SuiteStatus, error)
+	// GetEndpointHistory retrieves up to maxResults of the most recent results of an endpoint across pages.
+	GetEndpointHistory(ctx context.Context, key string, maxResults int, opts ...RequestOption) ([]EndpointResult, error)
+	// GetEndpointStatus retrieves the status of a specific endpoint by its group and name.
+	GetEndpointStatus(ctx context.Context, group, name string, opts ...RequestOption) (*EndpointStatus, error)
+	// EndpointExists reports whether an endpoint with the given key exists.
+	EndpointExists(ctx context.Context, key string, opts ...RequestOption) (bool, error)
+	// FindEndpoints retrieves the status of every endpoint whose key, name or group matches a glob or regular expression.
+	FindEndpoints(ctx context.Context, pattern string, opts ...RequestOption) ([]EndpointStatus, error)
+	// GetUnhealthyEndpoints retrieves the status of every endpoint whose most recent result failed.
+	GetUnhealthyEndpoints(ctx context.Context, opts ...RequestOption) ([]EndpointStatus, error)
+	// GetUnhealthyEndpointsInGroup retrieves the status of every endpoint of a group whose most recent result failed.
+	GetUnhealthyEndpointsInGroup(ctx context.Context, group string, opts ...RequestOption) ([]EndpointStatus, error)
+	// AssertAllHealthy returns an error listing every endpoint whose most recent result failed.
+	AssertAllHealthy(ctx context.Context, opts ...RequestOption) error
+	// AssertGroupHealthy returns an error listing every endpoint of a group whose most recent result failed.
+	AssertGroupHealthy(ctx context.Context, group string, opts ...RequestOption) error
+	// GetGroupHealth retrieves the status of every endpoint and counts them by group.
+	GetGroupHealth(ctx context.Context, opts ...RequestOption) (map[string]GroupHealth, error)
+	// GetAllEndpointStatusesAllPages retrieves the status of all configured endpoints with every result retained by Gatus.
+	GetAllEndpointStatusesAllPages(ctx context.Context, pageSize int, opts ...RequestOption) ([]EndpointStatus, error)
+	// GetEndpointStatusesByKeys retrieves the status of multiple endpoints concurrently.
+	GetEndpointStatusesByKeys(ctx context.Context, keys []string, concurrency int, opts ...RequestOption) (map[string]*EndpointStatus, error)
+	// GetEndpointUptimeBadgeURL returns the URL for an endpoint's uptime badge.
+	GetEndpointUptimeBadgeURL(key string, duration string, options ...BadgeOptions) string
+	// GetEndpointHealthBadgeURL returns the URL for an endpoint's health badge.
+	GetEndpointHealthBadgeURL(key string, options ...BadgeOptions) string
+	// GetEndpointResponseTimeBadgeURL returns the URL for an endpoint's response time badge.
+	GetEndpointResponseTimeBadgeURL(key string, duration string, options ...BadgeOptions) string
+	// GetEndpointUptime retrieves the uptime percentage for a specific endpoint.
+	GetEndpointUptime(ctx context.Context, key string, duration string, opts ...RequestOption) (float64, error)
+	// GetEndpointResponseTimes retrieves response time statistics for a specific endpoint.
+	GetEndpointResponseTimes(ctx context.Context, key string, duration string, opts ...RequestOption) (*ResponseTimeData, error)
+	// GetEndpointUptimeData retrieves raw uptime data for a specific endpoint.
+	GetEndpointUptimeData(ctx context.Context, key string, duration string, opts ...RequestOption) (*UptimeData, error)
+	// GetEndpointUptimeFor is the type-safe equivalent of GetEndpointUptime.
+	GetEndpointUptimeFor(ctx context.Context, key string, duration Duration, opts ...RequestOption) (float64, error)
+	// GetEndpointResponseTimesFor is the type-safe equivalent of GetEndpointResponseTimes.
+	GetEndpointResponseTimesFor(ctx context.Context, key string, duration Duration, opts ...RequestOption) (*ResponseTimeData, error)
+	// GetEndpointUptimeDataFor is the type-safe equivalent of GetEndpointUptimeData.
+	GetEndpointUptimeDataFor(ctx context.Context, key string, duration Duration, opts ...RequestOption) (*UptimeData, error)
+	// GetUptimes retrieves the uptime percentage of multiple endpoints concurrently.
+	GetUptimes(ctx context.Context, keys []string, duration string, opts ...RequestOption) (map[string]float64, error)
+	// PushExternalEndpointResult pushes a monitoring result to an external endpoint in Gatus.
+	PushExternalEndpointResult(ctx context.Context, key string, token string, success bool, errorMessage string, duration string, opts ...RequestOption) error
+	// GetAllSuiteStatuses retrieves the status of all configured suites.
+	GetAllSuiteStatuses(ctx context.Context, opts ...RequestOption) ([]SuiteStatus, error)
+	// GetAllSuiteStatusesAllPages retrieves the status of all configured suites with every result retained by Gatus.
+	GetAllSuiteStatusesAllPages(ctx context.Context, pageSize int, opts ...RequestOption) ([]SuiteStatus, error)
+	// GetSuiteStatusByKey retrieves the status of a specific suite by its key.
+	GetSuiteStatusByKey(ctx context.Context, key string, opts ...RequestOption) (*SuiteStatus, error)
+	// GetSuiteStatus retrieves the status of a specific suite by its group and name.
+	GetSuiteStatus(ctx context.Context, group, name string, opts ...RequestOption) (*SuiteStatus, error)
+	// GetSuiteHealthBadgeURL returns the URL for a suite's health badge.
+	GetSuiteHealthBadgeURL(key string, options ...BadgeOptions) string
+	// GetBadge retrieves the SVG of a badge whose URL was returned by one of the badge URL builders.
+	GetBadge(ctx context.Context, badgeURL string, opts ...RequestOption) ([]byte, error)
+	// WatchSuite polls the status of a suite and emits an event for each new suite execution result.
+	WatchSuite(ctx context.Context, key string, interval time.Duration, opts ...RequestOption) <-chan SuiteEvent
+	// WatchEndpoint polls the status of an endpoint and emits an event for each new result.
+	WatchEndpoint(ctx context.Context, key string, interval time.Duration, opts ...RequestOption) <-chan EndpointEvent
+	// NewPoller creates a Poller sharing a single poll per interval between endpoint watchers.
+	NewPoller(interval time.Duration, opts ...RequestOption) *Poller
+	// WatchGroup polls the status of every endpoint and emits an event whenever the aggregate health of a group changes.
+	WatchGroup(ctx context.Context, group string, interval time.Duration, opts ...RequestOption) <-chan GroupEvent
+	// Get performs a GET request on a path of the Gatus API that the SDK does not model yet.
+	Get(ctx context.Context, path string, out any, opts ...RequestOption) error
+	// Do performs a request on a path of the Gatus API that the SDK does not model yet.
+	Do(ctx context.Context, method, path string, body io.Reader, out any, opts ...RequestOption) error
+	// TakeSnapshot retrieves a frozen copy of the state of the instance.
+	TakeSnapshot(ctx context.Context, durations ...Duration) (*Snapshot, error)
+	// GenerateReport builds the report of the instance over a period, to be rendered with templates.
+	GenerateReport(ctx context.Context, options ReportOptions) (*Report, error)
+	// GenerateDigest generates the subject and bodies of a status digest email over a period.
+	GenerateDigest(ctx context.Context, reportOptions ReportOptions, options DigestOptions) (*Digest, error)
+	// PushMetrics pushes the metrics of a snapshot of every endpoint to a Prometheus Pushgateway.
+	PushMetrics(ctx context.Context, pushgatewayURL string, options PushgatewayOptions) error
+	// RecordHistory polls the status of every endpoint and stores the results in a HistoryStore until ctx is done.
+	RecordHistory(ctx context.Context, store *HistoryStore, interval time.Duration, opts ...RequestOption) error
+	// EmitStatsD polls the status of every endpoint and sends gauges describing it to a StatsD server until ctx is done.
+	EmitStatsD(ctx context.Context, address string, interval time.Duration, options StatsDOptions, opts ...RequestOption) error
+	// Stats returns a snapshot of the client's counters.
+	Stats() Stats
+}
+
+// Ensure Client implements ClientInterface.
+var _ ClientInterface = (*Client)(nil)
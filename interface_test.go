@@ -0,0 +1,26 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientInterface(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]EndpointStatus{{Name: "api", Group: "core", Key: "core_api"}})
+	}))
+	defer server.Close()
+
+	var client ClientInterface = NewClient(server.URL)
+	statuses, err := client.GetAllEndpointStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Key != "core_api" {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+}
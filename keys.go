@@ -1,6 +1,7 @@
 package gatussdk
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -32,3 +33,81 @@ func GenerateKey(group, name string) string {
 	}
 	return sanitizedGroup + "_" + sanitizedName
 }
+
+// invalidKeyCharacters contains the characters GenerateKey replaces, which therefore cannot appear in a key.
+const invalidKeyCharacters = "/,.#+&"
+
+// ValidateKey checks that key has the {group}_{name} shape produced by GenerateKey: a group and a non-empty name
+// separated by the first underscore, as split by ParseKey, neither containing any of the characters replaced by
+// GenerateKey ('/', ',', '.', '#', '+', '&').
+// It returns a *ValidationError describing the first problem found, or nil if the key is valid.
+//
+// Example:
+//
+//	if err := gatus.ValidateKey(input); err != nil {
+//	    return fmt.Errorf("invalid endpoint key %q: %w", input, err)
+//	}
+func ValidateKey(key string) error {
+	if key == "" {
+		return &ValidationError{
+			Field:   "key",
+			Message: "cannot be empty",
+		}
+	}
+	_, name, found := strings.Cut(key, "_")
+	if !found {
+		return &ValidationError{
+			Field:   "key",
+			Message: "must be in the format {group}_{name}",
+		}
+	}
+	if name == "" {
+		return &ValidationError{
+			Field:   "key",
+			Message: "name cannot be empty",
+		}
+	}
+	if i := strings.IndexAny(key, invalidKeyCharacters); i != -1 {
+		return &ValidationError{
+			Field:   "key",
+			Message: fmt.Sprintf("cannot contain '%c'", key[i]),
+		}
+	}
+	return nil
+}
+
+// GenerateSuiteKey generates the key of a suite based on its group and name.
+// Suite keys currently follow the same format as endpoint keys (see GenerateKey), but should be generated with
+// this function so that suite-specific normalization can be introduced without affecting endpoint keys.
+//
+// Examples:
+//   - GenerateSuiteKey("", "check-authentication") returns "_check-authentication"
+//   - GenerateSuiteKey("auth", "login.flow") returns "auth_login-flow"
+func GenerateSuiteKey(group, name string) string {
+	return GenerateKey(group, name)
+}
+
+// ValidateSuiteKey checks that key has the {group}_{name} shape produced by GenerateSuiteKey.
+// It applies the same rules as ValidateKey and returns a *ValidationError describing the first problem found,
+// or nil if the key is valid.
+func ValidateSuiteKey(key string) error {
+	return ValidateKey(key)
+}
+
+// ParseKey splits a key generated by GenerateKey back into its group and name.
+// The key is split on the first underscore, following Gatus conventions; since GenerateKey replaces
+// underscores in the group and name, the first underscore is always the separator.
+// Keys rejected by ValidateKey are rejected with the same *ValidationError.
+// Note that the characters replaced by GenerateKey cannot be recovered, so the group and name returned
+// are the sanitized versions.
+//
+// Examples:
+//   - ParseKey("core_blog-home") returns "core", "blog-home"
+//   - ParseKey("_standalone") returns "", "standalone"
+func ParseKey(key string) (group, name string, err error) {
+	if err := ValidateKey(key); err != nil {
+		return "", "", err
+	}
+	group, name, _ = strings.Cut(key, "_")
+	return group, name, nil
+}
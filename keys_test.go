@@ -226,3 +226,158 @@ func TestGenerateKey(t *testing.T) {
 		})
 	}
 }
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		expectedGroup string
+		expectedName  string
+		expectError   bool
+	}{
+		{
+			name:          "group and name",
+			key:           "core_blog-home",
+			expectedGroup: "core",
+			expectedName:  "blog-home",
+		},
+		{
+			name:          "empty group",
+			key:           "_standalone",
+			expectedGroup: "",
+			expectedName:  "standalone",
+		},
+		{
+			name:          "sanitized characters",
+			key:           "api-v1_health-check-test",
+			expectedGroup: "api-v1",
+			expectedName:  "health-check-test",
+		},
+		{
+			name:          "splits on first underscore",
+			key:           "core_a_b",
+			expectedGroup: "core",
+			expectedName:  "a_b",
+		},
+		{
+			name:        "missing separator",
+			key:         "core-api",
+			expectError: true,
+		},
+		{
+			name:        "empty name",
+			key:         "core_",
+			expectError: true,
+		},
+		{
+			name:        "empty key",
+			key:         "",
+			expectError: true,
+		},
+		{
+			name:        "invalid character",
+			key:         "api/v1_health",
+			expectError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group, name, err := ParseKey(tt.key)
+			if tt.expectError {
+				if _, ok := err.(*ValidationError); !ok {
+					t.Errorf("expected ValidationError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseKey() error = %v", err)
+			}
+			if group != tt.expectedGroup || name != tt.expectedName {
+				t.Errorf("ParseKey() = %q, %q, want %q, %q", group, name, tt.expectedGroup, tt.expectedName)
+			}
+		})
+	}
+}
+
+func TestParseKey_AgreesWithValidateKey(t *testing.T) {
+	for _, key := range []string{"core_api", "_standalone", "core_a_b", "a_b_c", "nonexistent", "core_", "", "core_a.b", "api/v1_health"} {
+		_, _, parseErr := ParseKey(key)
+		validateErr := ValidateKey(key)
+		if (parseErr == nil) != (validateErr == nil) {
+			t.Errorf("ParseKey(%q) error = %v, but ValidateKey(%q) error = %v", key, parseErr, key, validateErr)
+		}
+	}
+}
+
+func TestParseKey_RoundTrip(t *testing.T) {
+	for _, pair := range [][2]string{{"core", "api"}, {"", "standalone"}, {"api/v1", "health_check"}} {
+		key := GenerateKey(pair[0], pair[1])
+		group, name, err := ParseKey(key)
+		if err != nil {
+			t.Fatalf("ParseKey(%q) error = %v", key, err)
+		}
+		if GenerateKey(group, name) != key {
+			t.Errorf("GenerateKey(ParseKey(%q)) = %q", key, GenerateKey(group, name))
+		}
+	}
+}
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		expectError bool
+	}{
+		{name: "group and name", key: "core_blog-home"},
+		{name: "empty group", key: "_standalone"},
+		{name: "generated from special characters", key: GenerateKey("api/v1", "health_check.test")},
+		{name: "empty key", key: "", expectError: true},
+		{name: "missing separator", key: "core-api", expectError: true},
+		{name: "split on first separator", key: "core_api_v1"},
+		{name: "empty name", key: "core_", expectError: true},
+		{name: "slash", key: "api/v1_health", expectError: true},
+		{name: "dot", key: "core_health.check", expectError: true},
+		{name: "ampersand", key: "core_a&b", expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKey(tt.key)
+			if !tt.expectError {
+				if err != nil {
+					t.Errorf("ValidateKey(%q) error = %v", tt.key, err)
+				}
+				return
+			}
+			validationErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if validationErr.Field != "key" {
+				t.Errorf("Field = %v, want key", validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestGenerateSuiteKey(t *testing.T) {
+	tests := []struct {
+		group, name, expected string
+	}{
+		{group: "", name: "check-authentication", expected: "_check-authentication"},
+		{group: "auth", name: "login.flow", expected: "auth_login-flow"},
+	}
+	for _, tt := range tests {
+		key := GenerateSuiteKey(tt.group, tt.name)
+		if key != tt.expected {
+			t.Errorf("GenerateSuiteKey(%q, %q) = %v, want %v", tt.group, tt.name, key, tt.expected)
+		}
+		if err := ValidateSuiteKey(key); err != nil {
+			t.Errorf("ValidateSuiteKey(%q) error = %v", key, err)
+		}
+	}
+	for _, key := range []string{"", "check-authentication", "auth_login.flow"} {
+		if _, ok := ValidateSuiteKey(key).(*ValidationError); !ok {
+			t.Errorf("expected ValidationError for %q", key)
+		}
+	}
+}
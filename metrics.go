@@ -0,0 +1,69 @@
+package gatussdk
+
+import (
+	"strings"
+	"time"
+)
+
+// MetricsEvent describes a single request performed by the client.
+type MetricsEvent struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// PathTemplate is the path of the request with variable segments replaced by placeholders
+	// (e.g. "/api/v1/endpoints/{key}/uptimes/{duration}"), suitable as a low-cardinality metric label.
+	PathTemplate string
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the time taken to receive the response headers.
+	Duration time.Duration
+	// Retries is the number of retries performed before this request completed.
+	Retries int
+	// Timings breaks down the time spent in DNS resolution, connection establishment, TLS handshake
+	// and waiting for the first response byte.
+	Timings RequestTimings
+	// Err is the error that prevented a response from being received, if any.
+	Err error
+}
+
+// WithMetricsHook sets a function called after every request with details about that request.
+// The hook is called synchronously, so it should return quickly.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithMetricsHook(func(event MetricsEvent) {
+//	    requestDuration.WithLabelValues(event.Method, event.PathTemplate, strconv.Itoa(event.StatusCode)).Observe(event.Duration.Seconds())
+//	}))
+func WithMetricsHook(hook func(MetricsEvent)) ClientOption {
+	return func(c *Client) {
+		c.metricsHook = hook
+	}
+}
+
+// emitMetrics calls the metrics hook, if any, with the given event.
+func (c *Client) emitMetrics(event MetricsEvent) {
+	if c.metricsHook != nil {
+		c.metricsHook(event)
+	}
+}
+
+// pathTemplate returns the path with the query string removed and the key and duration segments
+// of Gatus API paths replaced by placeholders.
+func pathTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	// segments[0] is empty because the path starts with a slash
+	if len(segments) < 5 || segments[1] != "api" || (segments[3] != "endpoints" && segments[3] != "suites") {
+		return path
+	}
+	if segments[4] != "statuses" {
+		segments[4] = "{key}"
+	}
+	for i := 5; i < len(segments)-1; i++ {
+		if segments[i] == "uptimes" || segments[i] == "response-times" {
+			segments[i+1] = "{duration}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
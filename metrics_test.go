@@ -0,0 +1,73 @@
+package gatussdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithMetricsHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/endpoints/core_api/uptimes/24h" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	var events []MetricsEvent
+	client := NewClient(server.URL, WithMetricsHook(func(event MetricsEvent) {
+		events = append(events, event)
+	}))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if _, err := client.GetEndpointResponseTimes(context.Background(), "core_api", "24h"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if events[0].Method != http.MethodGet || events[0].PathTemplate != "/api/v1/endpoints/statuses" || events[0].StatusCode != http.StatusOK {
+		t.Errorf("unexpected first event: %+v", events[0])
+	}
+	if events[1].PathTemplate != "/api/v1/endpoints/{key}/response-times/{duration}" {
+		t.Errorf("PathTemplate = %v", events[1].PathTemplate)
+	}
+
+	t.Run("network error", func(t *testing.T) {
+		var event MetricsEvent
+		client := NewClient("http://127.0.0.1:0", WithMetricsHook(func(e MetricsEvent) { event = e }))
+		if _, err := client.GetAllSuiteStatuses(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+		if event.Err == nil || event.StatusCode != 0 {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	})
+}
+
+func TestPathTemplate(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/api/v1/endpoints/statuses", "/api/v1/endpoints/statuses"},
+		{"/api/v1/endpoints/core_api/statuses", "/api/v1/endpoints/{key}/statuses"},
+		{"/api/v1/endpoints/core_api/uptimes/7d", "/api/v1/endpoints/{key}/uptimes/{duration}"},
+		{"/api/v1/endpoints/core_api/external?success=true&error=boom", "/api/v1/endpoints/{key}/external"},
+		{"/api/v1/suites/statuses", "/api/v1/suites/statuses"},
+		{"/api/v1/suites/_check-auth/statuses", "/api/v1/suites/{key}/statuses"},
+		{"/health", "/health"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if actual := pathTemplate(tt.path); actual != tt.expected {
+				t.Errorf("pathTemplate() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package gatussdk
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -16,6 +17,41 @@ type EndpointStatus struct {
 	Results []EndpointResult `json:"results"`
 }
 
+// LastResult returns the most recent result of the endpoint, or nil if there is none.
+// It is safe to call on a nil status.
+//
+// Example:
+//
+//	if last := status.LastResult(); last != nil && !last.Success {
+//	    fmt.Printf("%s is down since %s: %v\n", status.Key, last.Timestamp, last.Errors)
+//	}
+func (s *EndpointStatus) LastResult() *EndpointResult {
+	if s == nil || len(s.Results) == 0 {
+		return nil
+	}
+	return &s.Results[len(s.Results)-1]
+}
+
+// LastSuccess returns the most recent successful result of the endpoint, or nil if there is none among its
+// results. It is safe to call on a nil status.
+//
+// Example:
+//
+//	if last := status.LastSuccess(); last != nil {
+//	    fmt.Printf("%s was last up at %s\n", status.Key, last.Timestamp)
+//	}
+func (s *EndpointStatus) LastSuccess() *EndpointResult {
+	if s == nil {
+		return nil
+	}
+	for i := len(s.Results) - 1; i >= 0; i-- {
+		if s.Results[i].Success {
+			return &s.Results[i]
+		}
+	}
+	return nil
+}
+
 // EndpointResult represents a single health check result for an endpoint.
 type EndpointResult struct {
 	// Status is the HTTP status code returned by the endpoint.
@@ -24,6 +60,8 @@ type EndpointResult struct {
 	Hostname string `json:"hostname,omitempty"`
 	// Duration is the time taken for the health check in nanoseconds.
 	Duration int64 `json:"duration"`
+	// Elapsed is Duration as a time.Duration. It is populated when decoding from JSON.
+	Elapsed time.Duration `json:"-"`
 	// ConditionResults contains the results of each condition check.
 	ConditionResults []ConditionResult `json:"conditionResults"`
 	// Success indicates whether the health check was successful.
@@ -32,6 +70,12 @@ type EndpointResult struct {
 	Timestamp time.Time `json:"timestamp"`
 	// Errors contains any error messages from the health check.
 	Errors []string `json:"errors,omitempty"`
+	// CertificateExpiration is the time left until the TLS certificate expires, if reported by the instance.
+	// Prefer CertificateExpiresIn, which also recovers the value from condition results.
+	CertificateExpiration time.Duration `json:"certificateExpiration,omitempty"`
+	// DomainExpiration is the time left until the domain registration expires, if reported by the instance.
+	// Prefer DomainExpiresIn, which also recovers the value from condition results.
+	DomainExpiration time.Duration `json:"domainExpiration,omitempty"`
 
 	///////////////////////////////////
 	// BELOW IS ONLY USED FOR SUITES //
@@ -41,6 +85,26 @@ type EndpointResult struct {
 	Name string `json:"name,omitempty"`
 }
 
+// MarshalJSON encodes an EndpointResult. If Duration is not set, it is populated from Elapsed, so that results
+// built with only Elapsed keep their duration once decoded.
+func (r EndpointResult) MarshalJSON() ([]byte, error) {
+	type alias EndpointResult
+	if r.Duration == 0 {
+		r.Duration = int64(r.Elapsed)
+	}
+	return json.Marshal(alias(r))
+}
+
+// UnmarshalJSON decodes an EndpointResult and populates Elapsed from Duration.
+func (r *EndpointResult) UnmarshalJSON(data []byte) error {
+	type alias EndpointResult
+	if err := json.Unmarshal(data, (*alias)(r)); err != nil {
+		return err
+	}
+	r.Elapsed = time.Duration(r.Duration)
+	return nil
+}
+
 // ConditionResult represents the result of a single condition check.
 type ConditionResult struct {
 	// Condition is the condition expression that was evaluated.
@@ -67,10 +131,44 @@ type ResponseTimeData struct {
 	Min int64 `json:"min"`
 	// Max is the maximum response time in nanoseconds.
 	Max int64 `json:"max"`
+	// AverageDuration is Average as a time.Duration. It is populated when decoding from JSON.
+	AverageDuration time.Duration `json:"-"`
+	// MinDuration is Min as a time.Duration. It is populated when decoding from JSON.
+	MinDuration time.Duration `json:"-"`
+	// MaxDuration is Max as a time.Duration. It is populated when decoding from JSON.
+	MaxDuration time.Duration `json:"-"`
 	// Timestamp is when the response time data was calculated.
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// MarshalJSON encodes a ResponseTimeData. Nanosecond fields that are not set are populated from their typed
+// counterparts, so that data built with only the typed durations keeps them once decoded.
+func (d ResponseTimeData) MarshalJSON() ([]byte, error) {
+	type alias ResponseTimeData
+	if d.Average == 0 {
+		d.Average = int64(d.AverageDuration)
+	}
+	if d.Min == 0 {
+		d.Min = int64(d.MinDuration)
+	}
+	if d.Max == 0 {
+		d.Max = int64(d.MaxDuration)
+	}
+	return json.Marshal(alias(d))
+}
+
+// UnmarshalJSON decodes a ResponseTimeData and populates the typed durations from their nanosecond counterparts.
+func (d *ResponseTimeData) UnmarshalJSON(data []byte) error {
+	type alias ResponseTimeData
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+	d.AverageDuration = time.Duration(d.Average)
+	d.MinDuration = time.Duration(d.Min)
+	d.MaxDuration = time.Duration(d.Max)
+	return nil
+}
+
 // SuiteStatus represents the status of a Gatus suite (a collection of sequential endpoint checks).
 type SuiteStatus struct {
 	// Name is the name of the suite.
@@ -93,6 +191,37 @@ type SuiteResult struct {
 	Timestamp time.Time `json:"timestamp"`
 	// Duration is the total time taken for the suite execution in nanoseconds.
 	Duration int64 `json:"duration"`
+	// Elapsed is Duration as a time.Duration. It is populated when decoding from JSON.
+	Elapsed time.Duration `json:"-"`
 	// EndpointResults contains the results of each endpoint check in the suite.
 	EndpointResults []EndpointResult `json:"endpointResults"`
+	// Context contains the context values stored by the suite's steps and referenced by [CONTEXT] placeholders
+	// (e.g. "user_id"). Values are kept as decoded from JSON, so every key reported by Gatus is preserved.
+	Context map[string]any `json:"context,omitempty"`
+}
+
+// MarshalJSON encodes a SuiteResult. If Duration is not set, it is populated from Elapsed, so that results
+// built with only Elapsed keep their duration once decoded.
+func (r SuiteResult) MarshalJSON() ([]byte, error) {
+	type alias SuiteResult
+	if r.Duration == 0 {
+		r.Duration = int64(r.Elapsed)
+	}
+	return json.Marshal(alias(r))
+}
+
+// UnmarshalJSON decodes a SuiteResult and populates Elapsed from Duration.
+func (r *SuiteResult) UnmarshalJSON(data []byte) error {
+	type alias SuiteResult
+	if err := json.Unmarshal(data, (*alias)(r)); err != nil {
+		return err
+	}
+	r.Elapsed = time.Duration(r.Duration)
+	return nil
+}
+
+// ErrorResponse represents the JSON body returned by Gatus when a request fails.
+type ErrorResponse struct {
+	// Error is the error message returned by Gatus.
+	Error string `json:"error"`
 }
@@ -2,6 +2,8 @@ package gatussdk
 
 import (
 	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -514,3 +516,205 @@ func compareResults(t *testing.T, got, expected EndpointResult) {
 		}
 	}
 }
+
+func TestTypedDurations_JSON(t *testing.T) {
+	t.Run("EndpointResult", func(t *testing.T) {
+		var result EndpointResult
+		if err := json.Unmarshal([]byte(`{"status":200,"duration":150000000,"success":true}`), &result); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if result.Elapsed != 150*time.Millisecond {
+			t.Errorf("Elapsed = %v, want 150ms", result.Elapsed)
+		}
+		if result.Duration != 150000000 || result.Status != 200 || !result.Success {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if strings.Contains(string(data), "Elapsed") || !strings.Contains(string(data), `"duration":150000000`) {
+			t.Errorf("unexpected wire format: %s", data)
+		}
+	})
+
+	t.Run("SuiteResult", func(t *testing.T) {
+		var result SuiteResult
+		if err := json.Unmarshal([]byte(`{"name":"suite","duration":2000000000,"endpointResults":[{"duration":1000000000}]}`), &result); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if result.Elapsed != 2*time.Second {
+			t.Errorf("Elapsed = %v, want 2s", result.Elapsed)
+		}
+		if len(result.EndpointResults) != 1 || result.EndpointResults[0].Elapsed != time.Second {
+			t.Errorf("unexpected endpoint results: %+v", result.EndpointResults)
+		}
+	})
+
+	t.Run("ResponseTimeData", func(t *testing.T) {
+		var data ResponseTimeData
+		if err := json.Unmarshal([]byte(`{"average":150000000,"min":100000000,"max":300000000}`), &data); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if data.AverageDuration != 150*time.Millisecond || data.MinDuration != 100*time.Millisecond || data.MaxDuration != 300*time.Millisecond {
+			t.Errorf("unexpected typed durations: %+v", data)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		var result EndpointResult
+		if err := json.Unmarshal([]byte(`{"duration":"fast"}`), &result); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestSuiteResult_Context(t *testing.T) {
+	data := `{"name":"check-authentication","success":true,"context":{"user_id":"42","token":{"expires_in":3600},"ids":[1,2]}}`
+	var result SuiteResult
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if result.Context["user_id"] != "42" {
+		t.Errorf("Context[user_id] = %v, want 42", result.Context["user_id"])
+	}
+	if token, ok := result.Context["token"].(map[string]any); !ok || token["expires_in"] != float64(3600) {
+		t.Errorf("Context[token] = %v, want nested object", result.Context["token"])
+	}
+	if ids, ok := result.Context["ids"].([]any); !ok || len(ids) != 2 {
+		t.Errorf("Context[ids] = %v, want two values", result.Context["ids"])
+	}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(encoded), `"user_id":"42"`) {
+		t.Errorf("expected context to round-trip, got %s", encoded)
+	}
+
+	var withoutContext SuiteResult
+	if err := json.Unmarshal([]byte(`{"name":"suite"}`), &withoutContext); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if withoutContext.Context != nil {
+		t.Errorf("Context = %v, want nil", withoutContext.Context)
+	}
+}
+
+func TestModels_RoundTrip(t *testing.T) {
+	timestamp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	result := EndpointResult{
+		Status:                200,
+		Hostname:              "example.org",
+		Duration:              int64(150 * time.Millisecond),
+		Elapsed:               150 * time.Millisecond,
+		ConditionResults:      []ConditionResult{{Condition: "[STATUS] == 200", Success: true}},
+		Success:               true,
+		Timestamp:             timestamp,
+		Errors:                []string{"slow"},
+		CertificateExpiration: 720 * time.Hour,
+		DomainExpiration:      8760 * time.Hour,
+	}
+	step := result
+	step.Name = "login"
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{name: "EndpointStatus", value: &EndpointStatus{Name: "api", Group: "core", Key: "core_api", Results: []EndpointResult{result}}},
+		{name: "UptimeData", value: &UptimeData{Uptime: 99.5, Duration: "24h", Timestamp: timestamp}},
+		{name: "ResponseTimeData", value: &ResponseTimeData{
+			Average: 150, Min: 100, Max: 200,
+			AverageDuration: 150, MinDuration: 100, MaxDuration: 200,
+			Timestamp: timestamp,
+		}},
+		{name: "SuiteStatus", value: &SuiteStatus{Name: "check", Key: "_check", Results: []SuiteResult{{
+			Name:            "check",
+			Success:         true,
+			Timestamp:       timestamp,
+			Duration:        int64(time.Second),
+			Elapsed:         time.Second,
+			EndpointResults: []EndpointResult{step},
+			Context:         map[string]any{"user_id": "42", "count": 3.0},
+		}}}},
+		{name: "ErrorResponse", value: &ErrorResponse{Error: "not found"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			decoded := reflect.New(reflect.TypeOf(tt.value).Elem()).Interface()
+			if err := json.Unmarshal(data, decoded); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(decoded, tt.value) {
+				t.Errorf("round trip mismatch:\ngot  %+v\nwant %+v", decoded, tt.value)
+			}
+		})
+	}
+
+	t.Run("typed durations only", func(t *testing.T) {
+		data, err := json.Marshal([]any{
+			EndpointResult{Elapsed: time.Second},
+			SuiteResult{Elapsed: time.Minute},
+			ResponseTimeData{AverageDuration: 2, MinDuration: 1, MaxDuration: 3},
+		})
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		var decoded []json.RawMessage
+		json.Unmarshal(data, &decoded)
+		var endpointResult EndpointResult
+		var suiteResult SuiteResult
+		var responseTimes ResponseTimeData
+		json.Unmarshal(decoded[0], &endpointResult)
+		json.Unmarshal(decoded[1], &suiteResult)
+		json.Unmarshal(decoded[2], &responseTimes)
+		if endpointResult.Elapsed != time.Second || suiteResult.Elapsed != time.Minute {
+			t.Errorf("expected Elapsed to survive a round trip, got %v and %v", endpointResult.Elapsed, suiteResult.Elapsed)
+		}
+		if responseTimes.Average != 2 || responseTimes.MinDuration != 1 || responseTimes.MaxDuration != 3 {
+			t.Errorf("expected typed durations to survive a round trip, got %+v", responseTimes)
+		}
+	})
+}
+
+func TestEndpointStatus_LastResult(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                string
+		status              *EndpointStatus
+		expectedLastResult  int
+		expectedLastSuccess int
+	}{
+		{name: "nil status", status: nil, expectedLastResult: -1, expectedLastSuccess: -1},
+		{name: "no results", status: &EndpointStatus{}, expectedLastResult: -1, expectedLastSuccess: -1},
+		{name: "healthy", status: &EndpointStatus{Results: []EndpointResult{{Success: false}, {Success: true}}}, expectedLastResult: 1, expectedLastSuccess: 1},
+		{name: "down", status: &EndpointStatus{Results: []EndpointResult{{Success: true}, {Success: false}, {Success: false}}}, expectedLastResult: 2, expectedLastSuccess: 0},
+		{name: "never up", status: &EndpointStatus{Results: []EndpointResult{{Success: false}}}, expectedLastResult: 0, expectedLastSuccess: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.status != nil {
+				for i := range tt.status.Results {
+					tt.status.Results[i].Timestamp = start.Add(time.Duration(i) * time.Minute)
+				}
+			}
+			check := func(method string, actual *EndpointResult, expected int) {
+				if expected < 0 {
+					if actual != nil {
+						t.Errorf("%s() = %+v, want nil", method, actual)
+					}
+					return
+				}
+				if actual != &tt.status.Results[expected] {
+					t.Errorf("%s() = %+v, want result %d", method, actual, expected)
+				}
+			}
+			check("LastResult", tt.status.LastResult(), tt.expectedLastResult)
+			check("LastSuccess", tt.status.LastSuccess(), tt.expectedLastSuccess)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+)
+
+// Instance is a named Gatus instance federated by a MultiClient.
+type Instance struct {
+	// Name identifies the instance (e.g. "us-east-1") in results and errors. It should be unique within a
+	// MultiClient, since the errors of instances sharing a name are joined.
+	Name string
+	// Client is the client used to query the instance.
+	Client ClientInterface
+}
+
+// InstanceEndpointStatus is an EndpointStatus annotated with the instance it was retrieved from.
+type InstanceEndpointStatus struct {
+	// Instance is the name of the instance the status was retrieved from.
+	Instance string `json:"instance"`
+	EndpointStatus
+}
+
+// InstanceSuiteStatus is a SuiteStatus annotated with the instance it was retrieved from.
+type InstanceSuiteStatus struct {
+	// Instance is the name of the instance the status was retrieved from.
+	Instance string `json:"instance"`
+	SuiteStatus
+}
+
+// MultiClient federates several Gatus instances, such as one per region, and merges their results.
+// Instances are queried concurrently.
+type MultiClient struct {
+	instances []Instance
+}
+
+// NewMultiClient creates a new MultiClient for the given instances.
+//
+// Example:
+//
+//	multi := gatus.NewMultiClient(
+//	    gatus.Instance{Name: "us-east-1", Client: gatus.NewClient("https://status.us-east-1.example.org")},
+//	    gatus.Instance{Name: "eu-west-1", Client: gatus.NewClient("https://status.eu-west-1.example.org")},
+//	)
+func NewMultiClient(instances ...Instance) *MultiClient {
+	return &MultiClient{instances: instances}
+}
+
+// Instances returns the instances federated by the MultiClient.
+func (m *MultiClient) Instances() []Instance {
+	return append([]Instance(nil), m.instances...)
+}
+
+// GetAllEndpointStatuses retrieves the status of all endpoints of every instance.
+// Statuses are ordered by instance, in the order the instances were given to NewMultiClient.
+//
+// If any instance could not be queried, a *BatchError containing the error for each failed instance name
+// is returned alongside the statuses of the instances that succeeded.
+//
+// Example:
+//
+//	statuses, err := multi.GetAllEndpointStatuses(context.Background())
+//	if err != nil {
+//	    log.Printf("some instances are unavailable: %v", err)
+//	}
+//	for _, status := range statuses {
+//	    fmt.Printf("[%s] %s\n", status.Instance, status.Name)
+//	}
+func (m *MultiClient) GetAllEndpointStatuses(ctx context.Context, opts ...RequestOption) ([]InstanceEndpointStatus, error) {
+	results := make([][]EndpointStatus, len(m.instances))
+	err := m.forEachInstance(ctx, func(ctx context.Context, i int, instance Instance) error {
+		statuses, err := instance.Client.GetAllEndpointStatuses(ctx, opts...)
+		results[i] = statuses
+		return err
+	})
+	var merged []InstanceEndpointStatus
+	for i, statuses := range results {
+		for _, status := range statuses {
+			merged = append(merged, InstanceEndpointStatus{Instance: m.instances[i].Name, EndpointStatus: status})
+		}
+	}
+	return merged, err
+}
+
+// GetAllSuiteStatuses retrieves the status of all suites of every instance.
+// Statuses are ordered by instance, in the order the instances were given to NewMultiClient.
+//
+// If any instance could not be queried, a *BatchError containing the error for each failed instance name
+// is returned alongside the statuses of the instances that succeeded.
+func (m *MultiClient) GetAllSuiteStatuses(ctx context.Context, opts ...RequestOption) ([]InstanceSuiteStatus, error) {
+	results := make([][]SuiteStatus, len(m.instances))
+	err := m.forEachInstance(ctx, func(ctx context.Context, i int, instance Instance) error {
+		statuses, err := instance.Client.GetAllSuiteStatuses(ctx, opts...)
+		results[i] = statuses
+		return err
+	})
+	var merged []InstanceSuiteStatus
+	for i, statuses := range results {
+		for _, status := range statuses {
+			merged = append(merged, InstanceSuiteStatus{Instance: m.instances[i].Name, SuiteStatus: status})
+		}
+	}
+	return merged, err
+}
+
+// forEachInstance calls fn concurrently for every instance, keyed by instance name in the returned *BatchError.
+// Instances are fanned out by index, so that instances sharing a name, or without one, are all queried. The
+// errors of instances sharing a name are joined.
+func (m *MultiClient) forEachInstance(ctx context.Context, fn func(ctx context.Context, i int, instance Instance) error) error {
+	failed := fanOutIndexes(ctx, len(m.instances), len(m.instances), func(ctx context.Context, i int) error {
+		return fn(ctx, i, m.instances[i])
+	})
+	if len(failed) == 0 {
+		return nil
+	}
+	errs := make(map[string]error, len(failed))
+	for i, instance := range m.instances {
+		err, ok := failed[i]
+		if !ok {
+			continue
+		}
+		if previous, ok := errs[instance.Name]; ok {
+			err = errors.Join(previous, err)
+		}
+		errs[instance.Name] = err
+	}
+	return &BatchError{Errors: errs}
+}
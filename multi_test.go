@@ -0,0 +1,116 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newInstanceServer(t *testing.T, endpoints []EndpointStatus, suites []SuiteStatus) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/endpoints/statuses":
+			json.NewEncoder(w).Encode(endpoints)
+		case "/api/v1/suites/statuses":
+			json.NewEncoder(w).Encode(suites)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMultiClient_GetAllEndpointStatuses(t *testing.T) {
+	east := newInstanceServer(t, []EndpointStatus{{Key: "core_api"}, {Key: "core_db"}}, nil)
+	west := newInstanceServer(t, []EndpointStatus{{Key: "core_api"}}, nil)
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+
+	multi := NewMultiClient(
+		Instance{Name: "us-east-1", Client: NewClient(east.URL)},
+		Instance{Name: "broken", Client: NewClient(broken.URL)},
+		Instance{Name: "eu-west-1", Client: NewClient(west.URL)},
+	)
+	statuses, err := multi.GetAllEndpointStatuses(context.Background())
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected BatchError, got %v", err)
+	}
+	if len(batchErr.Errors) != 1 || batchErr.Errors["broken"] == nil {
+		t.Errorf("unexpected errors: %v", batchErr.Errors)
+	}
+	if len(statuses) != 3 {
+		t.Fatalf("got %d statuses, want 3", len(statuses))
+	}
+	expected := []struct{ instance, key string }{{"us-east-1", "core_api"}, {"us-east-1", "core_db"}, {"eu-west-1", "core_api"}}
+	for i, status := range statuses {
+		if status.Instance != expected[i].instance || status.Key != expected[i].key {
+			t.Errorf("statuses[%d] = %s/%s, want %s/%s", i, status.Instance, status.Key, expected[i].instance, expected[i].key)
+		}
+	}
+}
+
+func TestMultiClient_GetAllSuiteStatuses(t *testing.T) {
+	east := newInstanceServer(t, nil, []SuiteStatus{{Key: "_check-authentication"}})
+	west := newInstanceServer(t, nil, []SuiteStatus{{Key: "_check-authentication"}, {Key: "_checkout"}})
+
+	multi := NewMultiClient(
+		Instance{Name: "us-east-1", Client: NewClient(east.URL)},
+		Instance{Name: "eu-west-1", Client: NewClient(west.URL)},
+	)
+	statuses, err := multi.GetAllSuiteStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("GetAllSuiteStatuses() error = %v", err)
+	}
+	if len(statuses) != 3 {
+		t.Fatalf("got %d statuses, want 3", len(statuses))
+	}
+	if statuses[0].Instance != "us-east-1" || statuses[2].Instance != "eu-west-1" || statuses[2].Key != "_checkout" {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+	data, err := json.Marshal(statuses[0])
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded map[string]any
+	json.Unmarshal(data, &decoded)
+	if decoded["instance"] != "us-east-1" || decoded["key"] != "_check-authentication" {
+		t.Errorf("expected flattened JSON with instance, got %s", data)
+	}
+}
+
+func TestMultiClient_UnnamedInstances(t *testing.T) {
+	first := newInstanceServer(t, []EndpointStatus{{Key: "core_api"}}, nil)
+	second := newInstanceServer(t, []EndpointStatus{{Key: "core_db"}}, nil)
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+
+	multi := NewMultiClient(
+		Instance{Client: NewClient(first.URL)},
+		Instance{Client: NewClient(second.URL)},
+		Instance{Name: "broken", Client: NewClient(broken.URL)},
+		Instance{Name: "broken", Client: NewClient(broken.URL)},
+	)
+	statuses, err := multi.GetAllEndpointStatuses(context.Background())
+	if len(statuses) != 2 || statuses[0].Key != "core_api" || statuses[1].Key != "core_db" {
+		t.Errorf("expected the statuses of both unnamed instances, got %+v", statuses)
+	}
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected BatchError, got %v", err)
+	}
+	var joined interface{ Unwrap() []error }
+	if len(batchErr.Errors) != 1 || !errors.As(batchErr.Errors["broken"], &joined) || len(joined.Unwrap()) != 2 {
+		t.Errorf("expected the joined errors of both broken instances, got %v", batchErr.Errors)
+	}
+}
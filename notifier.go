@@ -0,0 +1,304 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+const (
+	// DefaultNotificationTitleTemplate is the default template of the title of notifications.
+	DefaultNotificationTitleTemplate = `{{if .Resolved}}Resolved: {{end}}{{.Subject}} is {{.Current}}`
+	// DefaultNotificationTextTemplate is the default template of the text of notifications.
+	DefaultNotificationTextTemplate = `{{.Subject}} went from {{.Previous}} to {{.Current}}` +
+		`{{if .Resolved}} after {{.Duration}}{{end}}.` +
+		`{{range .Errors}}` + "\n" + `- {{.}}{{end}}` +
+		`{{if .Failing}}` + "\n" + `Failing: {{join .Failing ", "}}{{end}}`
+)
+
+// notificationTemplateFuncs are the functions available to notification templates, in addition to the builtins
+// of text/template.
+var notificationTemplateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+// Notification is a transition formatted for humans, as delivered by a Notifier to its sinks.
+type Notification struct {
+	Event
+	// Resolved is true if the transition brings the subject back up after a notified problem.
+	Resolved bool `json:"resolved"`
+	// Since is the time of the notified problem resolved by the transition, or of the problem itself otherwise.
+	Since time.Time `json:"since,omitzero"`
+	// Duration is how long the subject was down or degraded, for resolved notifications.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Title is the title rendered from the title template.
+	Title string `json:"title"`
+	// Text is the text rendered from the text template.
+	Text string `json:"text"`
+}
+
+// NotificationSink delivers notifications, e.g. to a chat or an incident management system.
+type NotificationSink interface {
+	// Send delivers notification.
+	Send(ctx context.Context, notification Notification) error
+}
+
+// NotificationSinkFunc is a function implementing NotificationSink.
+type NotificationSinkFunc func(ctx context.Context, notification Notification) error
+
+// Send calls f.
+func (f NotificationSinkFunc) Send(ctx context.Context, notification Notification) error {
+	return f(ctx, notification)
+}
+
+// httpNotificationSink is the NotificationSink returned by NewHTTPNotificationSink.
+type httpNotificationSink struct {
+	url        string
+	httpClient *http.Client
+	payload    func(Notification) any
+}
+
+// NewHTTPNotificationSink returns a NotificationSink posting the payload built by payload for each notification
+// as JSON to url. If httpClient is nil, http.DefaultClient is used.
+//
+// Example:
+//
+//	sink := NewHTTPNotificationSink("https://hooks.example.org/teams", nil, func(n Notification) any {
+//	    return map[string]string{"title": n.Title, "text": n.Text}
+//	})
+func NewHTTPNotificationSink(url string, httpClient *http.Client, payload func(Notification) any) NotificationSink {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &httpNotificationSink{url: url, httpClient: httpClient, payload: payload}
+}
+
+func (s *httpNotificationSink) Send(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(s.payload(notification))
+	if err != nil {
+		return fmt.Errorf("encoding notification: %w", err)
+	}
+	if err := postJSON(ctx, s.httpClient, s.url, "application/json", body); err != nil {
+		return fmt.Errorf("sending notification: %w", err)
+	}
+	return nil
+}
+
+// NewWebhookNotificationSink returns a NotificationSink posting each notification as JSON to url.
+// If httpClient is nil, http.DefaultClient is used.
+func NewWebhookNotificationSink(url string, httpClient *http.Client) NotificationSink {
+	return NewHTTPNotificationSink(url, httpClient, func(notification Notification) any {
+		return notification
+	})
+}
+
+// NewSlackNotificationSink returns a NotificationSink posting each notification to a Slack incoming webhook, with
+// the payload built by SlackPayload. If httpClient is nil, http.DefaultClient is used.
+func NewSlackNotificationSink(webhookURL string, httpClient *http.Client) NotificationSink {
+	return NewHTTPNotificationSink(webhookURL, httpClient, func(notification Notification) any {
+		return SlackPayload(notification)
+	})
+}
+
+// NewDiscordNotificationSink returns a NotificationSink posting each notification to a Discord webhook, with the
+// payload built by DiscordPayload. If httpClient is nil, http.DefaultClient is used.
+func NewDiscordNotificationSink(webhookURL string, httpClient *http.Client) NotificationSink {
+	return NewHTTPNotificationSink(webhookURL, httpClient, func(notification Notification) any {
+		return DiscordPayload(notification)
+	})
+}
+
+// SlackMessage is the payload of a Slack incoming webhook.
+type SlackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []SlackAttachment `json:"attachments,omitempty"`
+}
+
+// SlackAttachment is an attachment of a SlackMessage.
+type SlackAttachment struct {
+	Color string `json:"color,omitempty"`
+	Text  string `json:"text"`
+}
+
+// SlackPayload builds the Slack message of notification: the title as text, and the text in an attachment colored
+// green for resolved notifications and red otherwise. It can be modified before being posted, e.g. with
+// NewHTTPNotificationSink, to mention a channel.
+func SlackPayload(notification Notification) SlackMessage {
+	return SlackMessage{
+		Text:        notification.Title,
+		Attachments: []SlackAttachment{{Color: fmt.Sprintf("#%06x", notificationColor(notification)), Text: notification.Text}},
+	}
+}
+
+// DiscordMessage is the payload of a Discord webhook.
+type DiscordMessage struct {
+	Content string         `json:"content,omitempty"`
+	Embeds  []DiscordEmbed `json:"embeds,omitempty"`
+}
+
+// DiscordEmbed is an embed of a DiscordMessage.
+type DiscordEmbed struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Color       int    `json:"color"`
+}
+
+// DiscordPayload builds the Discord message of notification: an embed with the title and the text, colored green
+// for resolved notifications and red otherwise.
+func DiscordPayload(notification Notification) DiscordMessage {
+	return DiscordMessage{
+		Embeds: []DiscordEmbed{{Title: notification.Title, Description: notification.Text, Color: notificationColor(notification)}},
+	}
+}
+
+// notificationColor returns the RGB color of notification: green if the subject is up, red otherwise.
+func notificationColor(notification Notification) int {
+	if notification.Current == "up" {
+		return 0x36a64f
+	}
+	return 0xdd2e44
+}
+
+// NotifierOptions configures a Notifier.
+type NotifierOptions struct {
+	// TitleTemplate is the text/template of the title of notifications, executed with the Notification.
+	// Defaults to DefaultNotificationTitleTemplate. Templates can use the join function of the strings package.
+	TitleTemplate string
+	// TextTemplate is the text/template of the text of notifications, like TitleTemplate.
+	// Defaults to DefaultNotificationTextTemplate.
+	TextTemplate string
+	// MinInterval is the minimum interval between two notifications of problems of the same subject. Problems
+	// notified less than MinInterval after the previous one are dropped, so that a flapping endpoint doesn't flood
+	// the sinks. Resolved notifications are never dropped. Zero disables rate limiting.
+	MinInterval time.Duration
+	// Clock is the clock used for rate limiting and the duration of problems. Defaults to the system clock.
+	Clock Clock
+}
+
+// Notifier formats transitions into notifications with templates and delivers them to sinks. It implements
+// Publisher, so it can be fed with PublishEndpointEvents and PublishGroupEvents.
+//
+// A transition back up is only notified if the problem it resolves, a transition down or to a degraded state, was
+// notified; it is then marked as Resolved, with the Duration of the problem. This pairs each resolved notification
+// with a problem notification, even when problems are dropped by rate limiting.
+//
+// A Notifier is safe for concurrent use.
+type Notifier struct {
+	sinks       []NotificationSink
+	title       *template.Template
+	text        *template.Template
+	minInterval time.Duration
+	clock       Clock
+
+	mu       sync.Mutex
+	notified map[string]time.Time // Time of the last problem notified for each subject
+	open     map[string]time.Time // Start of the unresolved problem notified for each subject
+}
+
+// NewNotifier creates a Notifier delivering notifications to sinks. It returns an error if a template of options
+// cannot be parsed.
+//
+// Example:
+//
+//	notifier, err := NewNotifier([]NotificationSink{
+//	    NewSlackNotificationSink("https://hooks.slack.com/services/...", nil),
+//	}, NotifierOptions{MinInterval: 15 * time.Minute})
+//	if err != nil {
+//	    return err
+//	}
+//	go PublishEndpointEvents(ctx, notifier, client.WatchEndpoint(ctx, "core_api", time.Minute), nil)
+func NewNotifier(sinks []NotificationSink, options NotifierOptions) (*Notifier, error) {
+	if options.TitleTemplate == "" {
+		options.TitleTemplate = DefaultNotificationTitleTemplate
+	}
+	if options.TextTemplate == "" {
+		options.TextTemplate = DefaultNotificationTextTemplate
+	}
+	if options.Clock == nil {
+		options.Clock = systemClock{}
+	}
+	title, err := template.New("title").Funcs(notificationTemplateFuncs).Parse(options.TitleTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing title template: %w", err)
+	}
+	text, err := template.New("text").Funcs(notificationTemplateFuncs).Parse(options.TextTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing text template: %w", err)
+	}
+	return &Notifier{
+		sinks:       sinks,
+		title:       title,
+		text:        text,
+		minInterval: options.MinInterval,
+		clock:       options.Clock,
+		notified:    make(map[string]time.Time),
+		open:        make(map[string]time.Time),
+	}, nil
+}
+
+// Publish notifies event to every sink, unless it is dropped by rate limiting or is a transition back up without
+// a notified problem. It returns the errors of the sinks that failed, joined.
+func (n *Notifier) Publish(ctx context.Context, event Event) error {
+	notification, ok := n.notification(event)
+	if !ok {
+		return nil
+	}
+	var err error
+	if notification.Title, err = renderNotification(n.title, notification); err != nil {
+		return err
+	}
+	if notification.Text, err = renderNotification(n.text, notification); err != nil {
+		return err
+	}
+	var errs []error
+	for _, sink := range n.sinks {
+		if err := sink.Send(ctx, notification); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// notification returns the notification of event, and false if it must not be notified.
+func (n *Notifier) notification(event Event) (Notification, bool) {
+	now := n.clock.Now()
+	at := event.Time
+	if at.IsZero() {
+		at = now
+	}
+	key := event.Type + "/" + event.Subject
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	since, open := n.open[key]
+	if event.Current == "up" {
+		if !open {
+			return Notification{}, false
+		}
+		delete(n.open, key)
+		return Notification{Event: event, Resolved: true, Since: since, Duration: at.Sub(since)}, true
+	}
+	if last, ok := n.notified[key]; ok && n.minInterval > 0 && now.Sub(last) < n.minInterval {
+		return Notification{}, false
+	}
+	if !open {
+		since = at
+		n.open[key] = since
+	}
+	n.notified[key] = now
+	return Notification{Event: event, Since: since}, true
+}
+
+// renderNotification executes t with notification.
+func renderNotification(t *template.Template, notification Notification) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, notification); err != nil {
+		return "", fmt.Errorf("rendering %s template: %w", t.Name(), err)
+	}
+	return b.String(), nil
+}
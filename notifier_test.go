@@ -0,0 +1,177 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNotifier(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := &recordingClock{now: start}
+	var notifications []Notification
+	sink := NotificationSinkFunc(func(ctx context.Context, notification Notification) error {
+		notifications = append(notifications, notification)
+		return nil
+	})
+	notifier, err := NewNotifier([]NotificationSink{sink}, NotifierOptions{MinInterval: 10 * time.Minute, Clock: clock})
+	if err != nil {
+		t.Fatalf("NewNotifier() error = %v", err)
+	}
+	transition := func(minutes int, previous, current string, errs ...string) Event {
+		return Event{
+			Type:           EventTypeEndpointTransition,
+			Subject:        "core_api",
+			Time:           start.Add(time.Duration(minutes) * time.Minute),
+			TransitionData: TransitionData{Previous: previous, Current: current, Errors: errs},
+		}
+	}
+	steps := []struct {
+		minutes  int
+		event    Event
+		expected string
+	}{
+		{0, transition(0, "down", "up"), ""},
+		{1, transition(1, "up", "down", "timeout"), "core_api is down|core_api went from up to down.\n- timeout"},
+		{4, transition(4, "down", "up"), "Resolved: core_api is up|core_api went from down to up after 3m0s."},
+		{5, transition(5, "up", "down"), ""},
+		{6, transition(6, "down", "up"), ""},
+		{12, transition(12, "up", "down"), "core_api is down|core_api went from up to down."},
+		{13, transition(13, "down", "up"), "Resolved: core_api is up|core_api went from down to up after 1m0s."},
+	}
+	for _, step := range steps {
+		notifications = nil
+		clock.now = start.Add(time.Duration(step.minutes) * time.Minute)
+		if err := notifier.Publish(context.Background(), step.event); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+		var got string
+		if len(notifications) == 1 {
+			got = notifications[0].Title + "|" + notifications[0].Text
+		}
+		if len(notifications) > 1 || got != step.expected {
+			t.Errorf("at %dm: expected %q, got %d notifications: %q", step.minutes, step.expected, len(notifications), got)
+		}
+	}
+
+	t.Run("group", func(t *testing.T) {
+		notifications = nil
+		clock.now = start
+		event := Event{Type: EventTypeGroupTransition, Subject: "core", TransitionData: TransitionData{Previous: "up", Current: "partial", Failing: []string{"core_api", "core_db"}}}
+		if err := notifier.Publish(context.Background(), event); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+		clock.now = start.Add(30 * time.Second)
+		event.Previous, event.Current, event.Failing = "partial", "up", nil
+		if err := notifier.Publish(context.Background(), event); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+		if len(notifications) != 2 {
+			t.Fatalf("expected 2 notifications, got %d", len(notifications))
+		}
+		if expected := "core went from up to partial.\nFailing: core_api, core_db"; notifications[0].Text != expected {
+			t.Errorf("expected %q, got %q", expected, notifications[0].Text)
+		}
+		if !notifications[1].Resolved || notifications[1].Duration != 30*time.Second || !notifications[1].Since.Equal(start) {
+			t.Errorf("expected a notification resolving the problem after 30s, got %+v", notifications[1])
+		}
+	})
+
+	t.Run("custom templates", func(t *testing.T) {
+		notifier, err := NewNotifier([]NotificationSink{sink}, NotifierOptions{TitleTemplate: "[{{.Current}}] {{.Subject}}", TextTemplate: "{{len .Errors}} errors"})
+		if err != nil {
+			t.Fatalf("NewNotifier() error = %v", err)
+		}
+		notifications = nil
+		if err := notifier.Publish(context.Background(), transition(0, "up", "down", "timeout")); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+		if len(notifications) != 1 || notifications[0].Title != "[down] core_api" || notifications[0].Text != "1 errors" {
+			t.Errorf("unexpected notifications %+v", notifications)
+		}
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		if _, err := NewNotifier(nil, NotifierOptions{TextTemplate: "{{.Subject"}); err == nil {
+			t.Error("expected error parsing an invalid template")
+		}
+	})
+
+	t.Run("sink errors", func(t *testing.T) {
+		failing := NotificationSinkFunc(func(ctx context.Context, notification Notification) error {
+			return errors.New("unavailable")
+		})
+		notifier, _ := NewNotifier([]NotificationSink{failing, sink}, NotifierOptions{})
+		notifications = nil
+		err := notifier.Publish(context.Background(), transition(0, "up", "down"))
+		if err == nil || !strings.Contains(err.Error(), "unavailable") {
+			t.Errorf("expected the error of the failing sink, got %v", err)
+		}
+		if len(notifications) != 1 {
+			t.Errorf("expected the other sinks to be notified, got %d notifications", len(notifications))
+		}
+	})
+}
+
+func TestNotificationSinks(t *testing.T) {
+	notification := Notification{
+		Event:    Event{Type: EventTypeEndpointTransition, Subject: "core_api", TransitionData: TransitionData{Previous: "down", Current: "up"}},
+		Resolved: true,
+		Duration: time.Minute,
+		Title:    "Resolved: core_api is up",
+		Text:     "core_api went from down to up after 1m0s.",
+	}
+	tests := []struct {
+		name     string
+		sink     func(url string) NotificationSink
+		expected string
+	}{
+		{
+			name:     "webhook",
+			sink:     func(url string) NotificationSink { return NewWebhookNotificationSink(url, nil) },
+			expected: `{"type":"io.gatus.endpoint.transition","subject":"core_api","previous":"down","current":"up","resolved":true,"duration":60000000000,"title":"Resolved: core_api is up","text":"core_api went from down to up after 1m0s."}`,
+		},
+		{
+			name:     "slack",
+			sink:     func(url string) NotificationSink { return NewSlackNotificationSink(url, nil) },
+			expected: `{"text":"Resolved: core_api is up","attachments":[{"color":"#36a64f","text":"core_api went from down to up after 1m0s."}]}`,
+		},
+		{
+			name:     "discord",
+			sink:     func(url string) NotificationSink { return NewDiscordNotificationSink(url, nil) },
+			expected: `{"embeds":[{"title":"Resolved: core_api is up","description":"core_api went from down to up after 1m0s.","color":3581519}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				body = string(data)
+			}))
+			defer server.Close()
+			if err := tt.sink(server.URL).Send(context.Background(), notification); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			if body != tt.expected {
+				t.Errorf("got:\n%s\nwant:\n%s", body, tt.expected)
+			}
+		})
+	}
+
+	t.Run("non-2xx", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "invalid_token", http.StatusForbidden)
+		}))
+		defer server.Close()
+		err := NewSlackNotificationSink(server.URL, nil).Send(context.Background(), notification)
+		if err == nil || !strings.Contains(err.Error(), "403") || !strings.Contains(err.Error(), "invalid_token") {
+			t.Errorf("expected error with the status code and the body, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,197 @@
+package gatussdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MaxPageSize is the largest page size accepted by Gatus.
+const MaxPageSize = 100
+
+// GetAllEndpointStatusesAllPages retrieves the status of all configured endpoints with every result retained by
+// Gatus, rather than only the most recent ones.
+//
+// The first page is retrieved to find out whether more exist, after which the remaining pages are retrieved
+// concurrently, a batch at a time, until a page that is not full. The number of pages retrieved at once is the
+// client's batch concurrency (see WithBatchConcurrency). If pageSize is lower than 1, MaxPageSize is used.
+// Results recorded while retrieving pages shift them, so results already retrieved from a more recent page are
+// skipped, like in GetEndpointHistory.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatusesAllPages(context.Background(), 0)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, status := range statuses {
+//	    fmt.Printf("%s: %d results\n", status.Key, len(status.Results))
+//	}
+func (c *Client) GetAllEndpointStatusesAllPages(ctx context.Context, pageSize int, opts ...RequestOption) ([]EndpointStatus, error) {
+	if pageSize < 1 {
+		pageSize = MaxPageSize
+	}
+	pages, err := fetchPages(ctx, c.batchConcurrency, func(ctx context.Context, page int) ([]EndpointStatus, error) {
+		return c.GetAllEndpointStatuses(ctx, append(opts[:len(opts):len(opts)], WithPage(page, pageSize))...)
+	}, func(statuses []EndpointStatus) bool {
+		for _, status := range statuses {
+			if len(status.Results) >= pageSize {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergePages(pages, func(status *EndpointStatus) string { return status.Key }, func(status, older *EndpointStatus) {
+		status.Results = prependOlderResults(older.Results, status.Results, func(result *EndpointResult) time.Time { return result.Timestamp })
+	}), nil
+}
+
+// GetAllSuiteStatusesAllPages retrieves the status of all configured suites with every result retained by Gatus,
+// rather than only the most recent ones. Pages are retrieved like in GetAllEndpointStatusesAllPages.
+//
+// Example:
+//
+//	statuses, err := client.GetAllSuiteStatusesAllPages(context.Background(), 0)
+func (c *Client) GetAllSuiteStatusesAllPages(ctx context.Context, pageSize int, opts ...RequestOption) ([]SuiteStatus, error) {
+	if pageSize < 1 {
+		pageSize = MaxPageSize
+	}
+	pages, err := fetchPages(ctx, c.batchConcurrency, func(ctx context.Context, page int) ([]SuiteStatus, error) {
+		return c.GetAllSuiteStatuses(ctx, append(opts[:len(opts):len(opts)], WithPage(page, pageSize))...)
+	}, func(statuses []SuiteStatus) bool {
+		for _, status := range statuses {
+			if len(status.Results) >= pageSize {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergePages(pages, func(status *SuiteStatus) string { return status.Key }, func(status, older *SuiteStatus) {
+		status.Results = prependOlderResults(older.Results, status.Results, func(result *SuiteResult) time.Time { return result.Timestamp })
+	}), nil
+}
+
+// GetEndpointHistory retrieves up to maxResults of the most recent results of an endpoint, walking the pages
+// of results until it has enough or Gatus has no more. Results are returned in chronological order, oldest first.
+// If maxResults is lower than 1, every result retained by Gatus is returned.
+//
+// Pages are retrieved one at a time, so that no more requests than needed are sent. Results recorded while
+// walking shift the pages, so results already retrieved from a more recent page are skipped.
+//
+// Example:
+//
+//	results, err := client.GetEndpointHistory(ctx, "core_blog-home", 500)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, incident := range Incidents(results, IncidentOptions{}) {
+//	    fmt.Printf("%s: down for %s\n", incident.Start, incident.Duration)
+//	}
+func (c *Client) GetEndpointHistory(ctx context.Context, key string, maxResults int, opts ...RequestOption) ([]EndpointResult, error) {
+	pageSize := MaxPageSize
+	if maxResults >= 1 && maxResults < pageSize {
+		pageSize = maxResults
+	}
+	var history []EndpointResult
+	for page := 1; maxResults < 1 || len(history) < maxResults; page++ {
+		status, err := c.GetEndpointStatusByKey(ctx, key, append(opts[:len(opts):len(opts)], WithPage(page, pageSize))...)
+		if err != nil {
+			if page > 1 {
+				return nil, fmt.Errorf("fetching page %d: %w", page, err)
+			}
+			return nil, err
+		}
+		results := status.Results
+		if len(history) > 0 {
+			// Skip results that shifted from the previous page
+			oldest := history[0].Timestamp
+			for len(results) > 0 && !results[len(results)-1].Timestamp.Before(oldest) {
+				results = results[:len(results)-1]
+			}
+		}
+		history = append(results[:len(results):len(results)], history...)
+		if len(status.Results) < pageSize {
+			break
+		}
+	}
+	if maxResults >= 1 && len(history) > maxResults {
+		history = history[len(history)-maxResults:]
+	}
+	return history, nil
+}
+
+// fetchPages retrieves page 1 using fetch, then the following pages concurrently, up to concurrency at once,
+// until a page for which full returns false. Pages beyond that one are discarded.
+func fetchPages[T any](ctx context.Context, concurrency int, fetch func(ctx context.Context, page int) (T, error), full func(T) bool) ([]T, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+	first, err := fetch(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	pages := []T{first}
+	for full(pages[len(pages)-1]) {
+		// Each call writes to its own element of batch, so no lock is needed
+		batch := make([]T, concurrency)
+		failed := fanOutIndexes(ctx, concurrency, concurrency, func(ctx context.Context, i int) error {
+			result, err := fetch(ctx, len(pages)+1+i)
+			batch[i] = result
+			return err
+		})
+		if len(failed) > 0 {
+			errs := make(map[string]error, len(failed))
+			for i, err := range failed {
+				errs[strconv.Itoa(len(pages)+1+i)] = err
+			}
+			return nil, fmt.Errorf("fetching pages: %w", &BatchError{Errors: errs})
+		}
+		for _, page := range batch {
+			pages = append(pages, page)
+			if !full(page) {
+				break
+			}
+		}
+	}
+	return pages, nil
+}
+
+// mergePages merges the statuses of each page, ordered from newest to oldest, into a single status per key.
+// merge is called with each status and the status of the same key from the following, older page.
+// Statuses are ordered like in the first page they appear in.
+func mergePages[T any](pages [][]T, key func(*T) string, merge func(status, older *T)) []T {
+	var merged []T
+	indexes := make(map[string]int)
+	for _, page := range pages {
+		for _, status := range page {
+			i, ok := indexes[key(&status)]
+			if !ok {
+				indexes[key(&status)] = len(merged)
+				merged = append(merged, status)
+				continue
+			}
+			merge(&merged[i], &status)
+		}
+	}
+	return merged
+}
+
+// prependOlderResults prepends the results of an older page to results, both in chronological order. Results
+// recorded while retrieving pages shift them, so the results of the older page that are not before the oldest of
+// results, which were already retrieved, are skipped.
+func prependOlderResults[T any](older, results []T, timestamp func(*T) time.Time) []T {
+	if len(results) > 0 {
+		oldest := timestamp(&results[0])
+		for len(older) > 0 && !timestamp(&older[len(older)-1]).Before(oldest) {
+			older = older[:len(older)-1]
+		}
+	}
+	return append(older[:len(older):len(older)], results...)
+}
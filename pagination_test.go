@@ -0,0 +1,171 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_GetAllEndpointStatusesAllPages(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newStatus := func(key string, n int) EndpointStatus {
+		status := EndpointStatus{Key: key}
+		for i := 0; i < n; i++ {
+			status.Results = append(status.Results, EndpointResult{Timestamp: start.Add(time.Duration(i) * time.Minute)})
+		}
+		return status
+	}
+	all := []EndpointStatus{newStatus("core_api", 250), newStatus("core_web", 30)}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		var statuses []EndpointStatus
+		for _, status := range all {
+			end := len(status.Results) - (page-1)*pageSize
+			status.Results = status.Results[min(max(end-pageSize, 0), max(end, 0)):max(end, 0)]
+			statuses = append(statuses, status)
+		}
+		json.NewEncoder(w).Encode(statuses)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithBatchConcurrency(2))
+	statuses, err := client.GetAllEndpointStatusesAllPages(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("GetAllEndpointStatusesAllPages() error = %v", err)
+	}
+	// Page 1, then pages 2 and 3 concurrently, the latter not being full
+	if requests.Load() != 3 {
+		t.Errorf("got %d requests, want 3", requests.Load())
+	}
+	if len(statuses) != 2 || statuses[0].Key != "core_api" || statuses[1].Key != "core_web" {
+		t.Fatalf("unexpected statuses: %+v", statuses)
+	}
+	for i, status := range statuses {
+		if len(status.Results) != len(all[i].Results) {
+			t.Errorf("%s: got %d results, want %d", status.Key, len(status.Results), len(all[i].Results))
+			continue
+		}
+		for j, result := range status.Results {
+			if !result.Timestamp.Equal(all[i].Results[j].Timestamp) {
+				t.Errorf("%s: result %d has timestamp %v, want %v", status.Key, j, result.Timestamp, all[i].Results[j].Timestamp)
+				break
+			}
+		}
+	}
+}
+
+func TestClient_GetAllEndpointStatusesAllPages_ShiftedPages(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	results := newTimedResults(start, make([]time.Duration, 150)...)
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		all := results
+		if requests.Add(1) > 1 {
+			// A result is recorded after the first page, shifting the following ones
+			all = append(all[:len(all):len(all)], EndpointResult{Timestamp: start.Add(150 * time.Minute)})
+		}
+		end := max(len(all)-(page-1)*pageSize, 0)
+		json.NewEncoder(w).Encode([]EndpointStatus{{Key: "core_api", Results: all[max(end-pageSize, 0):end]}})
+	}))
+	defer server.Close()
+
+	statuses, err := NewClient(server.URL).GetAllEndpointStatusesAllPages(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("GetAllEndpointStatusesAllPages() error = %v", err)
+	}
+	if len(statuses) != 1 || len(statuses[0].Results) != len(results) {
+		t.Fatalf("expected %d results without duplicates, got %+v", len(results), statuses)
+	}
+	for i, result := range statuses[0].Results {
+		if !result.Timestamp.Equal(results[i].Timestamp) {
+			t.Errorf("result %d has timestamp %v, want %v", i, result.Timestamp, results[i].Timestamp)
+			break
+		}
+	}
+}
+
+func TestClient_GetAllSuiteStatusesAllPages_SinglePage(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Query().Get("pageSize") != strconv.Itoa(MaxPageSize) {
+			t.Errorf("pageSize = %v, want %d", r.URL.Query().Get("pageSize"), MaxPageSize)
+		}
+		json.NewEncoder(w).Encode([]SuiteStatus{{Key: "_suite", Results: []SuiteResult{{Success: true}}}})
+	}))
+	defer server.Close()
+
+	statuses, err := NewClient(server.URL).GetAllSuiteStatusesAllPages(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("GetAllSuiteStatusesAllPages() error = %v", err)
+	}
+	if requests.Load() != 1 || len(statuses) != 1 || len(statuses[0].Results) != 1 {
+		t.Errorf("got %d requests and %+v", requests.Load(), statuses)
+	}
+}
+
+func TestClient_GetEndpointHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var results []EndpointResult
+	for i := 0; i < 250; i++ {
+		results = append(results, EndpointResult{Timestamp: start.Add(time.Duration(i) * time.Minute)})
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		// Simulate 5 results recorded after the first page was retrieved, shifting the following pages
+		available := results
+		if page == 1 {
+			available = results[:len(results)-5]
+		}
+		end := len(available) - (page-1)*pageSize
+		json.NewEncoder(w).Encode(EndpointStatus{Key: "core_api", Results: available[max(end-pageSize, 0):max(end, 0)]})
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	tests := []struct {
+		name             string
+		maxResults       int
+		expectedResults  int
+		expectedRequests int32
+	}{
+		{name: "single page", maxResults: 10, expectedResults: 10, expectedRequests: 1},
+		{name: "several pages", maxResults: 150, expectedResults: 150, expectedRequests: 2},
+		{name: "server runs out", maxResults: 1000, expectedResults: 245, expectedRequests: 3},
+		{name: "all results", maxResults: 0, expectedResults: 245, expectedRequests: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests.Store(0)
+			history, err := client.GetEndpointHistory(context.Background(), "core_api", tt.maxResults)
+			if err != nil {
+				t.Fatalf("GetEndpointHistory() error = %v", err)
+			}
+			if len(history) != tt.expectedResults {
+				t.Fatalf("got %d results, want %d", len(history), tt.expectedResults)
+			}
+			if requests.Load() != tt.expectedRequests {
+				t.Errorf("got %d requests, want %d", requests.Load(), tt.expectedRequests)
+			}
+			last := start.Add(244 * time.Minute)
+			for i, result := range history {
+				if expected := last.Add(-time.Duration(len(history)-1-i) * time.Minute); !result.Timestamp.Equal(expected) {
+					t.Fatalf("result %d has timestamp %v, want %v", i, result.Timestamp, expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package gatussdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Poller shares a single GetAllEndpointStatuses request per interval between any number of endpoint watchers,
+// instead of issuing one request per watched endpoint. Polling starts when the first watcher subscribes and
+// stops once every watcher is gone, so a Poller can be created up front and left idle.
+//
+// A Poller is safe for concurrent use. Since Gatus paginates the results of each endpoint, the interval should
+// be short enough for the results of an endpoint not to exceed the first page between two polls.
+//
+// Example:
+//
+//	poller := client.NewPoller(30 * time.Second)
+//	for _, key := range []string{"core_api", "core_db", "core_cache"} {
+//	    go func() {
+//	        for event := range poller.WatchEndpoint(ctx, key) {
+//	            if event.Err == nil && event.SuccessChanged {
+//	                log.Printf("%s is now %s", key, event.Result.Severity())
+//	            }
+//	        }
+//	    }()
+//	}
+type Poller struct {
+	client   *Client
+	interval time.Duration
+	opts     []RequestOption
+
+	mu          sync.Mutex
+	subscribers map[*pollerSubscriber]struct{}
+	latest      *pollResult
+	stop        context.CancelFunc
+}
+
+// pollResult is the outcome of a poll.
+type pollResult struct {
+	statuses map[string]*EndpointStatus
+	err      error
+}
+
+// pollerSubscriber is an endpoint watcher subscribed to a Poller.
+type pollerSubscriber struct {
+	key string
+	// updates holds the latest poll result not yet processed by the watcher. Older results are replaced,
+	// so that a slow watcher never delays the poller or the other watchers.
+	updates chan *pollResult
+}
+
+// NewPoller creates a Poller polling the status of every endpoint every interval with the given request options.
+// If interval is zero or negative, DefaultPollInterval is used.
+func (c *Client) NewPoller(interval time.Duration, opts ...RequestOption) *Poller {
+	return &Poller{
+		client:      c,
+		interval:    interval,
+		opts:        opts,
+		subscribers: make(map[*pollerSubscriber]struct{}),
+	}
+}
+
+// WatchEndpoint emits an event on the returned channel for each new result of the endpoint with the given key,
+// like Client.WatchEndpoint, but using the polls shared by every watcher of the Poller. The first event contains
+// the most recent result known when WatchEndpoint is called. Polling errors, including the endpoint missing
+// from the statuses, are emitted as events with Err set.
+//
+// The channel is closed once ctx is done, unsubscribing the watcher.
+func (p *Poller) WatchEndpoint(ctx context.Context, key string) <-chan EndpointEvent {
+	events := make(chan EndpointEvent)
+	subscriber := &pollerSubscriber{key: key, updates: make(chan *pollResult, 1)}
+	p.subscribe(subscriber)
+	go func() {
+		defer close(events)
+		defer p.unsubscribe(subscriber)
+		var last *EndpointResult
+		for {
+			var result *pollResult
+			select {
+			case result = <-subscriber.updates:
+			case <-ctx.Done():
+				return
+			}
+			var polled []EndpointEvent
+			if result.err != nil {
+				polled = []EndpointEvent{{Key: key, Err: result.err}}
+			} else if status, ok := result.statuses[key]; ok {
+				polled = endpointEvents(key, status.Results, &last, p.client.flapping)
+			} else {
+				polled = []EndpointEvent{{Key: key, Err: fmt.Errorf("endpoint %q: %w", key, ErrNotFound)}}
+			}
+			for _, event := range polled {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events
+}
+
+// Subscribers returns the number of watchers currently subscribed to the Poller.
+func (p *Poller) Subscribers() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.subscribers)
+}
+
+// subscribe adds subscriber, starting to poll if it is the first one, or handing it the latest poll result otherwise.
+func (p *Poller) subscribe(subscriber *pollerSubscriber) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subscribers[subscriber] = struct{}{}
+	if p.stop == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.stop = cancel
+		go p.run(ctx)
+	} else if p.latest != nil {
+		subscriber.updates <- p.latest
+	}
+}
+
+// unsubscribe removes subscriber, stopping polling if it was the last one.
+func (p *Poller) unsubscribe(subscriber *pollerSubscriber) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.subscribers, subscriber)
+	if len(p.subscribers) == 0 && p.stop != nil {
+		p.stop()
+		p.stop = nil
+		p.latest = nil
+	}
+}
+
+// run polls every interval until ctx is done, publishing the results to the subscribers.
+func (p *Poller) run(ctx context.Context) {
+	schedule := p.client.newPollSchedule(p.interval)
+	for {
+		statuses, err := p.client.GetAllEndpointStatuses(ctx, p.opts...)
+		if ctx.Err() != nil {
+			return
+		}
+		result := &pollResult{err: err}
+		if err == nil {
+			result.statuses = make(map[string]*EndpointStatus, len(statuses))
+			for i := range statuses {
+				result.statuses[statuses[i].Key] = &statuses[i]
+			}
+		}
+		p.publish(ctx, result)
+		if !p.client.wait(ctx, schedule.jitter(schedule.interval)) {
+			return
+		}
+	}
+}
+
+// publish hands result to every subscriber, replacing any result they haven't processed yet.
+func (p *Poller) publish(ctx context.Context, result *pollResult) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if ctx.Err() != nil {
+		// Polling was stopped, possibly restarted by a new subscriber, while the request was in flight
+		return
+	}
+	p.latest = result
+	for subscriber := range p.subscribers {
+		select {
+		case <-subscriber.updates:
+		default:
+		}
+		subscriber.updates <- result
+	}
+}
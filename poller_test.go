@@ -0,0 +1,129 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// manualClock is a Clock whose timers only fire when the test sends on ticks.
+type manualClock struct {
+	systemClock
+	ticks chan time.Time
+}
+
+func (c *manualClock) NewTimer(time.Duration) Timer {
+	return manualTimer{c.ticks}
+}
+
+type manualTimer struct {
+	c chan time.Time
+}
+
+func (t manualTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (manualTimer) Stop() bool {
+	return true
+}
+
+func TestPoller(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	statuses := []EndpointStatus{
+		{Key: "core_api", Results: []EndpointResult{{Success: true, Timestamp: now}}},
+		{Key: "core_db", Results: []EndpointResult{{Success: true, Timestamp: now}}},
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(statuses)
+	}))
+	defer server.Close()
+
+	clock := &manualClock{ticks: make(chan time.Time)}
+	poller := NewClient(server.URL, WithClock(clock)).NewPoller(time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	apiCtx, cancelAPI := context.WithCancel(ctx)
+	api := poller.WatchEndpoint(apiCtx, "core_api")
+	db := poller.WatchEndpoint(ctx, "core_db")
+	if event := <-api; event.Err != nil || event.Key != "core_api" || !event.Result.Timestamp.Equal(now) {
+		t.Fatalf("unexpected first event: %+v", event)
+	}
+	if event := <-db; event.Err != nil || event.Key != "core_db" {
+		t.Fatalf("unexpected first event: %+v", event)
+	}
+	if event := <-poller.WatchEndpoint(ctx, "core_missing"); !errors.Is(event.Err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing endpoint, got %+v", event)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected watchers to share a single request, got %d", requests.Load())
+	}
+
+	mu.Lock()
+	statuses[0].Results = append(statuses[0].Results, EndpointResult{Success: false, Timestamp: now.Add(time.Minute)})
+	mu.Unlock()
+	clock.ticks <- now.Add(time.Minute)
+	if event := <-api; !event.SuccessChanged || event.Previous == nil || !event.Result.Timestamp.Equal(now.Add(time.Minute)) {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected a single request per interval, got %d", requests.Load())
+	}
+
+	cancelAPI()
+	if _, ok := <-api; ok {
+		t.Error("expected channel to be closed once the context is done")
+	}
+	if poller.Subscribers() != 2 {
+		t.Errorf("Subscribers() = %d, want 2", poller.Subscribers())
+	}
+	cancel()
+	for range db {
+	}
+	deadline := time.Now().Add(time.Second)
+	for poller.Subscribers() != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if poller.Subscribers() != 0 {
+		t.Errorf("Subscribers() = %d, want 0", poller.Subscribers())
+	}
+}
+
+func TestClient_WatchEndpoint(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	results := []EndpointResult{
+		{Success: true, Timestamp: now.Add(-time.Minute)},
+		{Success: true, Timestamp: now},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(EndpointStatus{Key: "core_api", Results: results})
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := NewClient(server.URL).WatchEndpoint(ctx, "core_api", 10*time.Millisecond)
+	if first := <-events; first.Err != nil || first.Previous != nil || !first.Result.Timestamp.Equal(now) {
+		t.Fatalf("unexpected first event: %+v", first)
+	}
+	mu.Lock()
+	results = append(results, EndpointResult{Success: false, Timestamp: now.Add(time.Minute)})
+	mu.Unlock()
+	if event := <-events; !event.SuccessChanged || event.Previous == nil || !event.Previous.Timestamp.Equal(now) {
+		t.Errorf("unexpected event: %+v", event)
+	}
+}
@@ -0,0 +1,113 @@
+package gatussdk
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// DefaultPollInterval is the interval used by watchers given an interval that is zero or negative.
+	DefaultPollInterval = 30 * time.Second
+	// DefaultPollingStableAfter is the default number of consecutive polls without changes after which
+	// a watched target is considered stable.
+	DefaultPollingStableAfter = 10
+)
+
+// PollingOptions configures the intervals between the polls of watchers. Zero values use the defaults,
+// which poll at exactly the interval passed to the watcher.
+type PollingOptions struct {
+	// Jitter randomizes each interval by up to this fraction of it, in either direction (e.g. 0.1 for ±10%),
+	// so that many replicas watching the same instance don't poll it in lockstep. It is capped at 1.
+	Jitter float64
+	// DownInterval is the interval used while the watched target is failing, typically shorter than the regular
+	// interval to notice recoveries sooner. Defaults to the regular interval.
+	DownInterval time.Duration
+	// StableInterval is the interval used once the watched target has been stable for StableAfter polls,
+	// typically longer than the regular interval to reduce the load on Gatus. Defaults to the regular interval.
+	StableInterval time.Duration
+	// StableAfter is the number of consecutive polls without changes after which the watched target is stable.
+	// Defaults to DefaultPollingStableAfter.
+	StableAfter int
+}
+
+func (o PollingOptions) withDefaults() PollingOptions {
+	o.Jitter = min(max(o.Jitter, 0), 1)
+	if o.StableAfter <= 0 {
+		o.StableAfter = DefaultPollingStableAfter
+	}
+	return o
+}
+
+// WithPolling configures the intervals between the polls of WatchEndpoint, WatchSuite and WatchGroup.
+// Jitter also applies to pollers and RecordHistory, which don't watch a single target.
+//
+// Example:
+//
+//	// Poll every 30s ±10%, every 5s while down, and every 2m once nothing changed for 10 polls
+//	client := NewClient("https://status.example.org", WithPolling(PollingOptions{
+//	    Jitter:         0.1,
+//	    DownInterval:   5 * time.Second,
+//	    StableInterval: 2 * time.Minute,
+//	}))
+//	events := client.WatchEndpoint(ctx, "core_api", 30*time.Second)
+func WithPolling(options PollingOptions) ClientOption {
+	return func(c *Client) {
+		c.polling = options
+	}
+}
+
+// pollSchedule computes the delays between the polls of a watcher.
+type pollSchedule struct {
+	interval    time.Duration
+	options     PollingOptions
+	stablePolls int
+}
+
+// newPollSchedule returns the schedule of a watcher polling every interval, or every DefaultPollInterval if
+// interval is zero or negative, which would otherwise poll Gatus in a tight loop.
+func (c *Client) newPollSchedule(interval time.Duration) *pollSchedule {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return &pollSchedule{interval: interval, options: c.polling.withDefaults()}
+}
+
+// next returns the delay before the next poll, given whether the watched target is failing and whether
+// it changed since the previous poll.
+func (s *pollSchedule) next(down, changed bool) time.Duration {
+	if changed {
+		s.stablePolls = 0
+	} else {
+		s.stablePolls++
+	}
+	interval := s.interval
+	switch {
+	case down && s.options.DownInterval > 0:
+		interval = s.options.DownInterval
+	case !down && s.stablePolls >= s.options.StableAfter && s.options.StableInterval > 0:
+		interval = s.options.StableInterval
+	}
+	return s.jitter(interval)
+}
+
+// jitter randomizes interval by up to the jitter fraction of it, in either direction.
+func (s *pollSchedule) jitter(interval time.Duration) time.Duration {
+	spread := time.Duration(float64(interval) * s.options.Jitter)
+	if spread <= 0 {
+		return interval
+	}
+	return interval - spread + rand.N(2*spread+1)
+}
+
+// wait waits for delay on the clock of c. It returns false if ctx is done first.
+func (c *Client) wait(ctx context.Context, delay time.Duration) bool {
+	timer := c.clock.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,198 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPollSchedule(t *testing.T) {
+	schedule := NewClient("https://example.com", WithPolling(PollingOptions{
+		DownInterval:   5 * time.Second,
+		StableInterval: 5 * time.Minute,
+		StableAfter:    2,
+	})).newPollSchedule(time.Minute)
+	steps := []struct {
+		down     bool
+		changed  bool
+		expected time.Duration
+	}{
+		{down: false, changed: true, expected: time.Minute},
+		{down: false, changed: false, expected: time.Minute},
+		{down: false, changed: false, expected: 5 * time.Minute},
+		{down: false, changed: false, expected: 5 * time.Minute},
+		{down: true, changed: true, expected: 5 * time.Second},
+		{down: true, changed: false, expected: 5 * time.Second},
+		{down: true, changed: false, expected: 5 * time.Second},
+		{down: false, changed: true, expected: time.Minute},
+	}
+	for i, step := range steps {
+		if actual := schedule.next(step.down, step.changed); actual != step.expected {
+			t.Errorf("step %d: next(%v, %v) = %v, want %v", i, step.down, step.changed, actual, step.expected)
+		}
+	}
+
+	defaults := NewClient("https://example.com").newPollSchedule(time.Minute)
+	for i := 0; i < 20; i++ {
+		if actual := defaults.next(i%2 == 0, false); actual != time.Minute {
+			t.Fatalf("expected the default schedule to use the interval, got %v", actual)
+		}
+	}
+}
+
+func TestPollSchedule_Jitter(t *testing.T) {
+	tests := []struct {
+		jitter float64
+		min    time.Duration
+		max    time.Duration
+	}{
+		{jitter: 0.1, min: 54 * time.Second, max: 66 * time.Second},
+		{jitter: 2, min: 0, max: 2 * time.Minute},
+		{jitter: -1, min: time.Minute, max: time.Minute},
+	}
+	for _, tt := range tests {
+		schedule := NewClient("https://example.com", WithPolling(PollingOptions{Jitter: tt.jitter})).newPollSchedule(time.Minute)
+		varied := false
+		for i := 0; i < 100; i++ {
+			actual := schedule.next(false, false)
+			if actual < tt.min || actual > tt.max {
+				t.Fatalf("jitter %v: next() = %v, want between %v and %v", tt.jitter, actual, tt.min, tt.max)
+			}
+			varied = varied || actual != time.Minute
+		}
+		if varied != (tt.min != tt.max) {
+			t.Errorf("jitter %v: expected intervals to vary: %v", tt.jitter, tt.min != tt.max)
+		}
+	}
+}
+
+func TestClient_WatchEndpoint_DownInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(EndpointStatus{Key: "core_api", Results: []EndpointResult{{Success: false, Timestamp: time.Now()}}})
+	}))
+	defer server.Close()
+
+	clock := &recordingClock{now: time.Now()}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := NewClient(server.URL, WithClock(clock), WithPolling(PollingOptions{DownInterval: 5 * time.Second}))
+	events := client.WatchEndpoint(ctx, "core_api", time.Minute)
+	<-events
+	<-events
+	cancel()
+	for range events {
+	}
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	if len(clock.timers) == 0 || clock.timers[0] != 5*time.Second {
+		t.Errorf("expected the watcher to poll every 5s while the endpoint is down, got %v", clock.timers)
+	}
+}
+
+func TestWatchers_DefaultInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/suites/_suite/statuses":
+			json.NewEncoder(w).Encode(SuiteStatus{Key: "_suite", Results: []SuiteResult{{Success: true, Timestamp: time.Now()}}})
+		case "/api/v1/endpoints/statuses":
+			json.NewEncoder(w).Encode([]EndpointStatus{{Key: "core_api", Group: "core", Results: []EndpointResult{{Success: true, Timestamp: time.Now()}}}})
+		default:
+			json.NewEncoder(w).Encode(EndpointStatus{Key: "core_api", Results: []EndpointResult{{Success: true, Timestamp: time.Now()}}})
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name  string
+		watch func(ctx context.Context, client *Client, interval time.Duration)
+	}{
+		{
+			name: "WatchSuite",
+			watch: func(ctx context.Context, client *Client, interval time.Duration) {
+				for range client.WatchSuite(ctx, "_suite", interval) {
+				}
+			},
+		},
+		{
+			name: "WatchEndpoint",
+			watch: func(ctx context.Context, client *Client, interval time.Duration) {
+				for range client.WatchEndpoint(ctx, "core_api", interval) {
+				}
+			},
+		},
+		{
+			name: "Poller",
+			watch: func(ctx context.Context, client *Client, interval time.Duration) {
+				for range client.NewPoller(interval).WatchEndpoint(ctx, "core_api") {
+				}
+			},
+		},
+		{
+			name: "WatchGroup",
+			watch: func(ctx context.Context, client *Client, interval time.Duration) {
+				for range client.WatchGroup(ctx, "core", interval) {
+				}
+			},
+		},
+		{
+			name: "EmitStatsD",
+			watch: func(ctx context.Context, client *Client, interval time.Duration) {
+				conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				defer conn.Close()
+				client.EmitStatsD(ctx, conn.LocalAddr().String(), interval, StatsDOptions{})
+			},
+		},
+		{
+			name: "RecordHistory",
+			watch: func(ctx context.Context, client *Client, interval time.Duration) {
+				store, err := OpenHistoryStore(filepath.Join(t.TempDir(), "history.jsonl"))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				defer store.Close()
+				client.RecordHistory(ctx, store, interval)
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, interval := range []time.Duration{0, -time.Second} {
+			t.Run(tt.name, func(t *testing.T) {
+				clock := &recordingClock{now: time.Now()}
+				client := NewClient(server.URL, WithClock(clock))
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					tt.watch(ctx, client, interval)
+				}()
+				for ctx.Err() == nil {
+					clock.mu.Lock()
+					waited := len(clock.timers) > 0
+					clock.mu.Unlock()
+					if waited {
+						break
+					}
+					time.Sleep(time.Millisecond)
+				}
+				cancel()
+				<-done
+				clock.mu.Lock()
+				defer clock.mu.Unlock()
+				if len(clock.timers) == 0 || clock.timers[0] != DefaultPollInterval {
+					t.Errorf("expected an interval of %v to poll every %v, got %v", interval, DefaultPollInterval, clock.timers)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package gatussdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// EventTypeEndpointTransition is the type of the events published when an endpoint goes up or down.
+	EventTypeEndpointTransition = "io.gatus.endpoint.transition"
+	// EventTypeGroupTransition is the type of the events published when the aggregate health of a group changes.
+	EventTypeGroupTransition = "io.gatus.group.transition"
+)
+
+// Event is a health transition, in a form independent of the transport used to deliver it.
+type Event struct {
+	// Type is the type of the transition, EventTypeEndpointTransition or EventTypeGroupTransition.
+	Type string `json:"type"`
+	// Subject is the key of the endpoint, or the name of the group.
+	Subject string `json:"subject"`
+	// Time is the timestamp of the result that triggered the transition, if known.
+	Time time.Time `json:"time,omitzero"`
+	TransitionData
+}
+
+// TransitionData describes the states before and after a health transition.
+type TransitionData struct {
+	// Previous is the previous state: "up" or "down" for endpoints, the name of a GroupState for groups.
+	Previous string `json:"previous"`
+	// Current is the new state, like Previous.
+	Current string `json:"current"`
+	// Errors contains the errors of the result of the endpoint that triggered the transition, if any.
+	Errors []string `json:"errors,omitempty"`
+	// Failing contains the keys of the failing endpoints of the group, if any.
+	Failing []string `json:"failing,omitempty"`
+}
+
+// EndpointTransitionEvent returns the transition reported by event, as emitted by WatchEndpoint. It returns false
+// if event is not a transition: errors, results that didn't change whether the endpoint succeeded and the first
+// result of a watcher.
+func EndpointTransitionEvent(event EndpointEvent) (Event, bool) {
+	if event.Err != nil || event.Previous == nil || !event.SuccessChanged {
+		return Event{}, false
+	}
+	return Event{
+		Type:    EventTypeEndpointTransition,
+		Subject: event.Key,
+		Time:    event.Result.Timestamp,
+		TransitionData: TransitionData{
+			Previous: upOrDown(event.Previous.Success),
+			Current:  upOrDown(event.Result.Success),
+			Errors:   event.Result.Errors,
+		},
+	}, true
+}
+
+// GroupTransitionEvent returns the transition reported by event, as emitted by WatchGroup. It returns false if
+// event is not a transition: errors, and the first event of a watcher, whose previous state is GroupStateUnknown.
+// Since group events don't carry the time of the results that triggered them, the returned event has no time.
+func GroupTransitionEvent(event GroupEvent) (Event, bool) {
+	if event.Err != nil || event.Previous == GroupStateUnknown {
+		return Event{}, false
+	}
+	return Event{
+		Type:    EventTypeGroupTransition,
+		Subject: event.Group,
+		TransitionData: TransitionData{
+			Previous: event.Previous.String(),
+			Current:  event.State.String(),
+			Failing:  event.Failing,
+		},
+	}, true
+}
+
+// upOrDown returns the state of an endpoint whose result succeeded or not.
+func upOrDown(success bool) string {
+	if success {
+		return "up"
+	}
+	return "down"
+}
+
+// Publisher publishes health transitions. Implementing it is all it takes to pump transitions into Kafka, NATS,
+// SQS or any other system, without the SDK depending on their clients.
+//
+// Example:
+//
+//	publisher := PublisherFunc(func(ctx context.Context, event Event) error {
+//	    payload, err := json.Marshal(event)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    return natsConn.Publish("gatus.transitions."+event.Subject, payload)
+//	})
+type Publisher interface {
+	// Publish publishes event.
+	Publish(ctx context.Context, event Event) error
+}
+
+// PublisherFunc is a function implementing Publisher.
+type PublisherFunc func(ctx context.Context, event Event) error
+
+// Publish calls f.
+func (f PublisherFunc) Publish(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// webhookPublisher is the Publisher returned by NewWebhookPublisher.
+type webhookPublisher struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewWebhookPublisher returns a Publisher posting each event as JSON to url.
+// If httpClient is nil, http.DefaultClient is used.
+//
+// Example:
+//
+//	publisher := NewWebhookPublisher("https://hooks.example.org/gatus", nil)
+func NewWebhookPublisher(url string, httpClient *http.Client) Publisher {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &webhookPublisher{url: url, httpClient: httpClient}
+}
+
+func (p *webhookPublisher) Publish(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding event: %w", err)
+	}
+	if err := postJSON(ctx, p.httpClient, p.url, "application/json", body); err != nil {
+		return fmt.Errorf("publishing event: %w", err)
+	}
+	return nil
+}
+
+// postJSON posts body to url with the given content type, returning an error for non-2xx responses.
+func postJSON(ctx context.Context, httpClient *http.Client, url, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, MaxNonJSONPreviewLength))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// jsonPublisher is the Publisher returned by NewJSONPublisher.
+type jsonPublisher struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONPublisher returns a Publisher writing each event to w as a line of JSON, e.g. to os.Stdout for log
+// shippers. The publisher is safe for concurrent use.
+//
+// Example:
+//
+//	publisher := NewJSONPublisher(os.Stdout)
+//	// {"type":"io.gatus.endpoint.transition","subject":"core_api","time":"2025-01-01T12:00:00Z","previous":"up","current":"down"}
+func NewJSONPublisher(w io.Writer) Publisher {
+	return &jsonPublisher{encoder: json.NewEncoder(w)}
+}
+
+func (p *jsonPublisher) Publish(ctx context.Context, event Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.encoder.Encode(event); err != nil {
+		return fmt.Errorf("writing event: %w", err)
+	}
+	return nil
+}
+
+// NewCloudEventPublisher returns a Publisher wrapping each event into a CloudEvent from source (see
+// Event.CloudEvent) and delivering it with sender.
+func NewCloudEventPublisher(source string, sender CloudEventSender) Publisher {
+	return PublisherFunc(func(ctx context.Context, event Event) error {
+		return sender.Send(ctx, event.CloudEvent(source))
+	})
+}
+
+// PublishEndpointEvents publishes each transition among events (see EndpointTransitionEvent) with publisher, until
+// events is closed or ctx is done. If onError is not nil, it is called with each event that couldn't be published,
+// and publishing continues with the next event.
+//
+// Example:
+//
+//	go PublishEndpointEvents(ctx, NewJSONPublisher(os.Stdout), client.WatchEndpoint(ctx, "core_api", time.Minute), nil)
+func PublishEndpointEvents(ctx context.Context, publisher Publisher, events <-chan EndpointEvent, onError func(Event, error)) {
+	publishEvents(ctx, publisher, events, EndpointTransitionEvent, onError)
+}
+
+// PublishGroupEvents publishes each transition among events (see GroupTransitionEvent) with publisher, like
+// PublishEndpointEvents.
+func PublishGroupEvents(ctx context.Context, publisher Publisher, events <-chan GroupEvent, onError func(Event, error)) {
+	publishEvents(ctx, publisher, events, GroupTransitionEvent, onError)
+}
+
+// publishEvents publishes the transitions among the watcher events until events is closed or ctx is done.
+func publishEvents[E any](ctx context.Context, publisher Publisher, events <-chan E, transition func(E) (Event, bool), onError func(Event, error)) {
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			event, ok := transition(e)
+			if !ok {
+				continue
+			}
+			if err := publisher.Publish(ctx, event); err != nil && onError != nil {
+				onError(event, err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package gatussdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEndpointTransitionEvent(t *testing.T) {
+	timestamp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	event, ok := EndpointTransitionEvent(EndpointEvent{
+		Key:            "core_api",
+		Result:         EndpointResult{Success: false, Timestamp: timestamp, Errors: []string{"timeout"}},
+		Previous:       &EndpointResult{Success: true},
+		SuccessChanged: true,
+	})
+	if !ok {
+		t.Fatal("expected a transition")
+	}
+	expected := Event{
+		Type:           EventTypeEndpointTransition,
+		Subject:        "core_api",
+		Time:           timestamp,
+		TransitionData: TransitionData{Previous: "up", Current: "down", Errors: []string{"timeout"}},
+	}
+	if !reflect.DeepEqual(event, expected) {
+		t.Errorf("got %+v, want %+v", event, expected)
+	}
+	data, _ := json.Marshal(event)
+	if expected := `{"type":"io.gatus.endpoint.transition","subject":"core_api","time":"2025-01-01T12:00:00Z","previous":"up","current":"down","errors":["timeout"]}`; string(data) != expected {
+		t.Errorf("got JSON %s, want %s", data, expected)
+	}
+}
+
+func TestNewWebhookPublisher(t *testing.T) {
+	var contentType string
+	var received Event
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &received)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	event := Event{Type: EventTypeGroupTransition, Subject: "core", TransitionData: TransitionData{Previous: "up", Current: "down"}}
+	publisher := NewWebhookPublisher(server.URL, nil)
+	if err := publisher.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if contentType != "application/json" || !reflect.DeepEqual(received, event) {
+		t.Errorf("unexpected request: %s %+v", contentType, received)
+	}
+	status = http.StatusServiceUnavailable
+	if err := publisher.Publish(context.Background(), event); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("expected an error with the status code, got %v", err)
+	}
+}
+
+func TestNewJSONPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := NewJSONPublisher(&buf)
+	for _, current := range []string{"down", "up"} {
+		if err := publisher.Publish(context.Background(), Event{Type: EventTypeEndpointTransition, Subject: "core_api", TransitionData: TransitionData{Current: current}}); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+	expected := `{"type":"io.gatus.endpoint.transition","subject":"core_api","previous":"","current":"down"}
+{"type":"io.gatus.endpoint.transition","subject":"core_api","previous":"","current":"up"}
+`
+	if buf.String() != expected {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), expected)
+	}
+}
+
+func TestNewCloudEventPublisher(t *testing.T) {
+	var sent CloudEvent
+	publisher := NewCloudEventPublisher("test", CloudEventSenderFunc(func(ctx context.Context, event CloudEvent) error {
+		sent = event
+		return nil
+	}))
+	event := Event{Type: EventTypeEndpointTransition, Subject: "core_api", Time: time.Unix(1, 0), TransitionData: TransitionData{Previous: "up", Current: "down"}}
+	if err := publisher.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if sent.Source != "test" || sent.ID != "core_api@1000000000" || sent.Type != EventTypeEndpointTransition || string(sent.Data) != `{"previous":"up","current":"down"}` {
+		t.Errorf("unexpected CloudEvent %+v", sent)
+	}
+}
+
+func TestPublishEvents(t *testing.T) {
+	up := &EndpointResult{Success: true}
+	var published []string
+	var failed []string
+	publisher := PublisherFunc(func(ctx context.Context, event Event) error {
+		published = append(published, event.Subject+":"+event.Current)
+		if event.Subject == "core_db" {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+	onError := func(event Event, err error) {
+		failed = append(failed, event.Subject)
+	}
+	endpointEvents := make(chan EndpointEvent, 3)
+	endpointEvents <- EndpointEvent{Key: "core_api", Result: *up}
+	endpointEvents <- EndpointEvent{Key: "core_api", Result: EndpointResult{Success: false}, Previous: up, SuccessChanged: true}
+	endpointEvents <- EndpointEvent{Key: "core_db", Result: EndpointResult{Success: false}, Previous: up, SuccessChanged: true}
+	close(endpointEvents)
+	PublishEndpointEvents(context.Background(), publisher, endpointEvents, onError)
+
+	groupEvents := make(chan GroupEvent, 2)
+	groupEvents <- GroupEvent{Group: "core", State: GroupStateUp}
+	groupEvents <- GroupEvent{Group: "core", State: GroupStatePartial, Previous: GroupStateUp}
+	close(groupEvents)
+	PublishGroupEvents(context.Background(), publisher, groupEvents, nil)
+
+	if expected := []string{"core_api:down", "core_db:down", "core:partial"}; !reflect.DeepEqual(published, expected) {
+		t.Errorf("got %v, want %v", published, expected)
+	}
+	if !reflect.DeepEqual(failed, []string{"core_db"}) {
+		t.Errorf("expected the failed publication to be reported, got %v", failed)
+	}
+}
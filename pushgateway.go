@@ -0,0 +1,172 @@
+package gatussdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DefaultPushgatewayJob is the default job under which PushMetrics pushes metrics.
+const DefaultPushgatewayJob = "gatus"
+
+// PushgatewayOptions configures PushMetrics. Zero values use the defaults.
+type PushgatewayOptions struct {
+	// Job is the job under which metrics are pushed. Defaults to DefaultPushgatewayJob.
+	Job string
+	// Instance is the instance under which metrics are pushed, if any, to separate the metrics of several
+	// Gatus instances pushed under the same job.
+	Instance string
+	// Durations are the durations for which the uptime of every endpoint is pushed. Defaults to Duration24h.
+	Durations []Duration
+	// HTTPClient is the client used to push metrics. Defaults to http.DefaultClient, as the transport of the
+	// Gatus client may be configured specifically for Gatus.
+	HTTPClient *http.Client
+}
+
+// PushMetrics takes a snapshot of every endpoint (see TakeSnapshot) and pushes its metrics (see
+// Snapshot.WriteMetrics) to the Prometheus Pushgateway at pushgatewayURL, replacing the metrics previously
+// pushed under the same job and instance. It is meant for batch jobs that can't be scraped.
+// If the uptime of some endpoints could not be retrieved, the other metrics are still pushed, and the *BatchError
+// returned by TakeSnapshot is returned.
+//
+// Example:
+//
+//	err := client.PushMetrics(context.Background(), "http://pushgateway:9091", PushgatewayOptions{
+//	    Job:       "gatus-export",
+//	    Durations: []Duration{Duration24h, Duration7d},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) PushMetrics(ctx context.Context, pushgatewayURL string, options PushgatewayOptions) error {
+	if pushgatewayURL == "" {
+		return &ValidationError{Field: "pushgatewayURL", Message: "cannot be empty"}
+	}
+	if options.Job == "" {
+		options.Job = DefaultPushgatewayJob
+	}
+	if len(options.Durations) == 0 {
+		options.Durations = []Duration{Duration24h}
+	}
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+	snapshot, snapshotErr := c.TakeSnapshot(ctx, options.Durations...)
+	if snapshot == nil {
+		return snapshotErr
+	}
+	var body bytes.Buffer
+	if err := snapshot.WriteMetrics(&body); err != nil {
+		return err
+	}
+	pushURL := strings.TrimSuffix(pushgatewayURL, "/") + "/metrics/" + pushgatewayLabel("job", options.Job)
+	if options.Instance != "" {
+		pushURL += "/" + pushgatewayLabel("instance", options.Instance)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, pushURL, &body)
+	if err != nil {
+		return fmt.Errorf("creating push request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain; version=0.0.4")
+	resp, err := options.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("pushing metrics: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, MaxNonJSONPreviewLength))
+		return fmt.Errorf("pushing metrics: unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return snapshotErr
+}
+
+// pushgatewayLabel returns the path segments of a grouping label of the Pushgateway, base64-encoding values that
+// can't be represented in a path segment.
+func pushgatewayLabel(name, value string) string {
+	switch {
+	case value == "":
+		return name + "@base64/="
+	case strings.Contains(value, "/"):
+		return name + "@base64/" + base64.URLEncoding.EncodeToString([]byte(value))
+	}
+	return name + "/" + url.PathEscape(value)
+}
+
+// WriteMetrics writes the metrics of the snapshot to w in the Prometheus text exposition format. Metric names follow
+// those exposed by Gatus itself:
+//
+//	gatus_results_endpoint_success{key,group,name}   1 if the most recent result succeeded, 0 otherwise
+//	gatus_results_duration_seconds{key,group,name}   response time of the most recent result
+//	gatus_results_uptime{key,group,name,duration}    uptime of the endpoint over duration, as reported by Gatus
+//
+// Endpoints without results only have uptime metrics.
+func (s *Snapshot) WriteMetrics(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# HELP gatus_results_endpoint_success Whether the most recent result of the endpoint succeeded.\n")
+	b.WriteString("# TYPE gatus_results_endpoint_success gauge\n")
+	for i := range s.Endpoints {
+		if last := s.Endpoints[i].LastResult(); last != nil {
+			value := 0
+			if last.Success {
+				value = 1
+			}
+			writeMetric(&b, "gatus_results_endpoint_success", endpointLabels(&s.Endpoints[i]), strconv.Itoa(value))
+		}
+	}
+	b.WriteString("# HELP gatus_results_duration_seconds Response time of the most recent result of the endpoint.\n")
+	b.WriteString("# TYPE gatus_results_duration_seconds gauge\n")
+	for i := range s.Endpoints {
+		if last := s.Endpoints[i].LastResult(); last != nil {
+			writeMetric(&b, "gatus_results_duration_seconds", endpointLabels(&s.Endpoints[i]), strconv.FormatFloat(last.Elapsed.Seconds(), 'g', -1, 64))
+		}
+	}
+	if len(s.Uptimes) > 0 {
+		b.WriteString("# HELP gatus_results_uptime Uptime of the endpoint over the duration.\n")
+		b.WriteString("# TYPE gatus_results_uptime gauge\n")
+		for i := range s.Endpoints {
+			uptimes := s.Uptimes[s.Endpoints[i].Key]
+			for _, duration := range slices.Sorted(maps.Keys(uptimes)) {
+				labels := append(endpointLabels(&s.Endpoints[i]), "duration", string(duration))
+				writeMetric(&b, "gatus_results_uptime", labels, strconv.FormatFloat(uptimes[duration], 'g', -1, 64))
+			}
+		}
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing metrics: %w", err)
+	}
+	return nil
+}
+
+// endpointLabels returns the names and values of the labels identifying an endpoint in metrics.
+func endpointLabels(status *EndpointStatus) []string {
+	return []string{"key", status.Key, "group", status.Group, "name", status.Name}
+}
+
+// metricLabelEscaper escapes label values in the Prometheus text exposition format.
+var metricLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// writeMetric writes a sample of a metric, with labels given as alternating names and values.
+func writeMetric(b *strings.Builder, name string, labels []string, value string) {
+	b.WriteString(name)
+	b.WriteByte('{')
+	for i := 0; i+1 < len(labels); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(labels[i])
+		b.WriteString(`="`)
+		b.WriteString(metricLabelEscaper.Replace(labels[i+1]))
+		b.WriteByte('"')
+	}
+	b.WriteString("} ")
+	b.WriteString(value)
+	b.WriteByte('\n')
+}
@@ -0,0 +1,121 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnapshot_WriteMetrics(t *testing.T) {
+	snapshot := &Snapshot{
+		Endpoints: []EndpointStatus{
+			{Key: "core_api", Group: "core", Name: "api", Results: []EndpointResult{{Success: true, Elapsed: 150 * time.Millisecond}}},
+			{Key: "_odd", Name: `say "hi"\`, Results: []EndpointResult{{Success: false, Elapsed: 2 * time.Second}}},
+			{Key: "core_new", Group: "core", Name: "new"},
+		},
+		Uptimes: map[string]map[Duration]float64{
+			"core_api": {Duration7d: 0.95, Duration24h: 1},
+			"core_new": {Duration24h: 0},
+		},
+	}
+	var b strings.Builder
+	if err := snapshot.WriteMetrics(&b); err != nil {
+		t.Fatalf("WriteMetrics() error = %v", err)
+	}
+	expected := `# HELP gatus_results_endpoint_success Whether the most recent result of the endpoint succeeded.
+# TYPE gatus_results_endpoint_success gauge
+gatus_results_endpoint_success{key="core_api",group="core",name="api"} 1
+gatus_results_endpoint_success{key="_odd",group="",name="say \"hi\"\\"} 0
+# HELP gatus_results_duration_seconds Response time of the most recent result of the endpoint.
+# TYPE gatus_results_duration_seconds gauge
+gatus_results_duration_seconds{key="core_api",group="core",name="api"} 0.15
+gatus_results_duration_seconds{key="_odd",group="",name="say \"hi\"\\"} 2
+# HELP gatus_results_uptime Uptime of the endpoint over the duration.
+# TYPE gatus_results_uptime gauge
+gatus_results_uptime{key="core_api",group="core",name="api",duration="24h"} 1
+gatus_results_uptime{key="core_api",group="core",name="api",duration="7d"} 0.95
+gatus_results_uptime{key="core_new",group="core",name="new",duration="24h"} 0
+`
+	if b.String() != expected {
+		t.Errorf("got:\n%s\nwant:\n%s", b.String(), expected)
+	}
+}
+
+func TestClient_PushMetrics(t *testing.T) {
+	gatus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/endpoints/statuses":
+			w.Write([]byte(`[{"key": "core_api", "group": "core", "name": "api", "results": [{"success": true, "duration": 1000000}]}]`))
+		case "/api/v1/endpoints/core_api/uptimes/24h":
+			w.Write([]byte(`0.98`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer gatus.Close()
+	var method, path, contentType, body string
+	pushgatewayStatus := http.StatusOK
+	pushgateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		method, path, contentType, body = r.Method, r.URL.EscapedPath(), r.Header.Get("Content-Type"), string(data)
+		w.WriteHeader(pushgatewayStatus)
+		if pushgatewayStatus != http.StatusOK {
+			w.Write([]byte("invalid metric"))
+		}
+	}))
+	defer pushgateway.Close()
+
+	client := NewClient(gatus.URL)
+	tests := []struct {
+		name         string
+		options      PushgatewayOptions
+		expectedPath string
+	}{
+		{name: "default job", expectedPath: "/metrics/job/gatus"},
+		{name: "job and instance", options: PushgatewayOptions{Job: "export", Instance: "eu-west"}, expectedPath: "/metrics/job/export/instance/eu-west"},
+		{name: "instance with slash", options: PushgatewayOptions{Instance: "status.example.org/internal"}, expectedPath: "/metrics/job/gatus/instance@base64/c3RhdHVzLmV4YW1wbGUub3JnL2ludGVybmFs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := client.PushMetrics(context.Background(), pushgateway.URL+"/", tt.options); err != nil {
+				t.Fatalf("PushMetrics() error = %v", err)
+			}
+			if method != http.MethodPut || path != tt.expectedPath {
+				t.Errorf("expected PUT %s, got %s %s", tt.expectedPath, method, path)
+			}
+			if !strings.HasPrefix(contentType, "text/plain") {
+				t.Errorf("unexpected content type %q", contentType)
+			}
+			for _, metric := range []string{
+				`gatus_results_endpoint_success{key="core_api",group="core",name="api"} 1`,
+				`gatus_results_duration_seconds{key="core_api",group="core",name="api"} 0.001`,
+				`gatus_results_uptime{key="core_api",group="core",name="api",duration="24h"} 0.98`,
+			} {
+				if !strings.Contains(body, metric+"\n") {
+					t.Errorf("expected %s in pushed metrics:\n%s", metric, body)
+				}
+			}
+		})
+	}
+
+	t.Run("rejected", func(t *testing.T) {
+		pushgatewayStatus = http.StatusBadRequest
+		defer func() { pushgatewayStatus = http.StatusOK }()
+		err := client.PushMetrics(context.Background(), pushgateway.URL, PushgatewayOptions{})
+		if err == nil || !strings.Contains(err.Error(), "400") || !strings.Contains(err.Error(), "invalid metric") {
+			t.Errorf("expected the status code and message of the Pushgateway, got %v", err)
+		}
+	})
+
+	t.Run("missing URL", func(t *testing.T) {
+		var validationErr *ValidationError
+		if err := client.PushMetrics(context.Background(), "", PushgatewayOptions{}); !errors.As(err, &validationErr) {
+			t.Errorf("expected *ValidationError, got %v", err)
+		}
+	})
+}
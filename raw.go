@@ -0,0 +1,48 @@
+package gatussdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Get performs a GET request on a path of the Gatus API that the SDK does not model yet,
+// and decodes the JSON response into out.
+// The request is sent with the same headers, compression, logging, metrics and error handling as
+// the other methods, so non-2xx responses are returned as an *APIError.
+//
+// Example:
+//
+//	var config map[string]any
+//	if err := client.Get(context.Background(), "/api/v1/config", &config); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) Get(ctx context.Context, path string, out any, opts ...RequestOption) error {
+	return c.Do(ctx, http.MethodGet, path, nil, out, opts...)
+}
+
+// Do performs a request with the given method and body on a path of the Gatus API that the SDK does not model yet,
+// and decodes the JSON response into out. The body, if not nil, is sent with "Content-Type: application/json",
+// which can be overridden with WithHeader. If out is nil, the response body is discarded.
+// Non-2xx responses are returned as an *APIError.
+//
+// Example:
+//
+//	err := client.Do(context.Background(), http.MethodPost, "/api/v1/some-endpoint", strings.NewReader(`{"enabled":true}`), nil)
+func (c *Client) Do(ctx context.Context, method, path string, body io.Reader, out any, opts ...RequestOption) error {
+	if method == "" {
+		return &ValidationError{
+			Field:   "method",
+			Message: "cannot be empty",
+		}
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	resp, err := c.do(ctx, method, path, body, "", opts)
+	if err != nil {
+		return err
+	}
+	return c.decodeResponse(resp, out)
+}
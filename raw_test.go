@@ -0,0 +1,64 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_Get(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/config" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Accept") != "application/json" || r.Header.Get("Content-Type") != "" {
+			t.Errorf("unexpected headers: %v", r.Header)
+		}
+		w.Write([]byte(`{"oidc":false}`))
+	}))
+	defer server.Close()
+
+	var config map[string]any
+	if err := NewClient(server.URL).Get(context.Background(), "api/v1/config", &config); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if config["oidc"] != false {
+		t.Errorf("unexpected config: %v", config)
+	}
+}
+
+func TestClient_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || string(body) != `{"enabled":true}` {
+			t.Errorf("unexpected request: %s %s", r.Method, body)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Content-Type = %v, want application/json", r.Header.Get("Content-Type"))
+		}
+		if r.URL.Query().Get("force") == "true" {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":"already enabled"}`))
+			return
+		}
+		w.Write([]byte(`{"ignored":true}`))
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	if err := client.Do(context.Background(), http.MethodPost, "/api/v1/feature", strings.NewReader(`{"enabled":true}`), nil); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	err := client.Do(context.Background(), http.MethodPost, "/api/v1/feature", strings.NewReader(`{"enabled":true}`), nil, WithQueryParam("force", "true"))
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict || apiErr.APIMessage != "already enabled" {
+		t.Errorf("expected 409 APIError, got %v", err)
+	}
+	if _, ok := client.Do(context.Background(), "", "/api/v1/feature", nil, nil).(*ValidationError); !ok {
+		t.Error("expected ValidationError for empty method")
+	}
+}
@@ -0,0 +1,90 @@
+package gatussdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_RedactsCredentials(t *testing.T) {
+	const (
+		bearerToken   = "bearer-secret-123"
+		pushToken     = "push-secret-456"
+		basicPassword = "basic-secret-789"
+		queryToken    = "query-secret-000"
+	)
+	secrets := []string{bearerToken, pushToken, basicPassword, queryToken, base64.StdEncoding.EncodeToString([]byte("user:" + basicPassword))}
+	// The server echoes the credentials it received, as a misbehaving proxy might
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": "invalid credentials ` + r.Header.Get("Authorization") + " " + r.URL.RawQuery + `"}`))
+	}))
+	defer server.Close()
+	basicAuthURL := strings.Replace(server.URL, "://", "://user:"+basicPassword+"@", 1)
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	scenarios := []struct {
+		name    string
+		request func(ctx context.Context) error
+	}{
+		{
+			name: "bearer token",
+			request: func(ctx context.Context) error {
+				_, err := NewClient(server.URL, WithBearerToken(bearerToken), WithLogger(logger)).GetAllEndpointStatuses(ctx)
+				return err
+			},
+		},
+		{
+			name: "basic auth",
+			request: func(ctx context.Context) error {
+				_, err := NewClient(basicAuthURL, WithLogger(logger)).GetAllEndpointStatuses(ctx)
+				return err
+			},
+		},
+		{
+			name: "push token",
+			request: func(ctx context.Context) error {
+				return NewClient(server.URL, WithLogger(logger)).PushExternalEndpointResult(ctx, "core_backup", pushToken, true, "", "", WithQueryParam("token", queryToken))
+			},
+		},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			buffer.Reset()
+			err := scenario.request(context.Background())
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %v", err)
+			}
+			for _, secret := range secrets {
+				for name, output := range map[string]string{"error": err.Error(), "body": apiErr.Body, "API message": apiErr.APIMessage, "logs": buffer.String()} {
+					if strings.Contains(output, secret) {
+						t.Errorf("%s contains secret %s: %s", name, secret, output)
+					}
+				}
+			}
+			if !strings.Contains(apiErr.APIMessage, "invalid credentials") {
+				t.Errorf("expected the rest of the API message to be kept, got %v", apiErr.APIMessage)
+			}
+		})
+	}
+
+	t.Run("transport error", func(t *testing.T) {
+		buffer.Reset()
+		client := NewClient("http://127.0.0.1:1", WithLogger(logger))
+		_, err := client.GetAllEndpointStatuses(context.Background(), WithQueryParam("token", queryToken))
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if strings.Contains(err.Error(), queryToken) || strings.Contains(buffer.String(), queryToken) {
+			t.Errorf("expected query token to be redacted, got %v\n%s", err, buffer.String())
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package gatussdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DefaultReportTemplate is the text/template of the Markdown reports written by Report.WriteMarkdown. It can be
+// used as a starting point for custom templates.
+const DefaultReportTemplate = `# Status Report
+
+Generated: {{.GeneratedAt.Format "2006-01-02T15:04:05Z07:00"}}
+
+Overall status: **{{.Summary.Status}}** ({{.Summary.Up}}/{{.Summary.Total}} endpoints healthy)
+{{range .Groups}}
+## {{if .Name}}{{.Name}}{{else}}Ungrouped{{end}}
+
+| Endpoint | Health | Uptime ({{$.Duration}}) | Avg. Response Time | Incidents |
+|----------|--------|--------|--------------------|-----------|
+{{range .Endpoints}}| {{.Name}} | {{.Severity}} | {{if .HasUptime}}{{percent .Uptime}}{{else}}n/a{{end}} | {{milliseconds .AverageResponseTime}} | {{len .Incidents}} |
+{{end}}{{end}}`
+
+// Report is the data model of status reports: the health, uptime, response time and incidents of every endpoint
+// over a period, by group. It is meant to be rendered with templates (see Report.Render), so that custom report
+// formats only need a template.
+type Report struct {
+	// GeneratedAt is when the data of the report was retrieved. It is the end of the period covered by the report.
+	GeneratedAt time.Time `json:"generatedAt"`
+	// Duration is the period covered by the report.
+	Duration Duration `json:"duration"`
+	// From is the start of the period covered by the report.
+	From time.Time `json:"from"`
+	// Summary summarizes the current health of the endpoints.
+	Summary Summary `json:"summary"`
+	// Groups contains the endpoints by group, sorted by name, with ungrouped endpoints last.
+	Groups []ReportGroup `json:"groups"`
+}
+
+// ReportGroup is a group of endpoints of a Report.
+type ReportGroup struct {
+	// Name is the name of the group, empty for endpoints without a group.
+	Name string `json:"name"`
+	// Endpoints contains the endpoints of the group, sorted by name.
+	Endpoints []ReportEndpoint `json:"endpoints"`
+}
+
+// ReportEndpoint is an endpoint of a Report.
+type ReportEndpoint struct {
+	// Key is the key of the endpoint.
+	Key string `json:"key"`
+	// Group is the group of the endpoint.
+	Group string `json:"group,omitempty"`
+	// Name is the name of the endpoint.
+	Name string `json:"name"`
+	// Severity is the current health of the endpoint, based on its most recent result.
+	Severity Severity `json:"severity"`
+	// Uptime is the uptime percentage of the endpoint over the period, as computed by Gatus.
+	Uptime float64 `json:"uptime"`
+	// HasUptime indicates whether the uptime is known.
+	HasUptime bool `json:"hasUptime"`
+	// AverageResponseTime is the average response time of the results within the period.
+	AverageResponseTime time.Duration `json:"averageResponseTime"`
+	// Incidents contains the incidents overlapping the period, in chronological order.
+	Incidents []Incident `json:"incidents,omitempty"`
+}
+
+// ReportOptions configures a Report. Zero values use the defaults.
+type ReportOptions struct {
+	// Duration is the period covered by the report. Defaults to Duration7d.
+	Duration Duration
+	// Incidents configures how incidents are synthesized from results.
+	Incidents IncidentOptions
+}
+
+// GenerateReport takes a snapshot of the instance (see TakeSnapshot) and builds its report (see NewReport).
+// If the uptime of some endpoints could not be retrieved, the report is built without it, and the *BatchError
+// returned by TakeSnapshot is returned alongside the report.
+//
+// Example:
+//
+//	report, err := client.GenerateReport(ctx, ReportOptions{Duration: Duration7d})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	err = report.WriteMarkdown(os.Stdout)
+func (c *Client) GenerateReport(ctx context.Context, options ReportOptions) (*Report, error) {
+	if options.Duration == "" {
+		options.Duration = Duration7d
+	}
+	snapshot, err := c.TakeSnapshot(ctx, options.Duration)
+	if snapshot == nil {
+		return nil, err
+	}
+	return NewReport(snapshot, options), err
+}
+
+// NewReport builds the report of snapshot over the period of options ending when the snapshot was taken. Uptimes
+// are those of the snapshot for the duration of options, if it contains them. Response times and incidents are
+// computed from the results within the period, which only go back as far as the results kept by Gatus.
+func NewReport(snapshot *Snapshot, options ReportOptions) *Report {
+	if options.Duration == "" {
+		options.Duration = Duration7d
+	}
+	report := &Report{
+		GeneratedAt: snapshot.Timestamp,
+		Duration:    options.Duration,
+		From:        snapshot.Timestamp.Add(-options.Duration.window()),
+		Summary:     Summarize(snapshot.Endpoints),
+		Groups:      []ReportGroup{},
+	}
+	statuses := slices.Clone(snapshot.Endpoints)
+	SortStatuses(statuses, ByGroupThenName)
+	for i := range statuses {
+		status := &statuses[i]
+		if len(report.Groups) == 0 || report.Groups[len(report.Groups)-1].Name != status.Group {
+			report.Groups = append(report.Groups, ReportGroup{Name: status.Group})
+		}
+		endpoint := ReportEndpoint{Key: status.Key, Group: status.Group, Name: status.Name, Severity: status.Severity()}
+		endpoint.Uptime, endpoint.HasUptime = snapshot.Uptime(status.Key, options.Duration)
+		results := FilterResultsBetween(status.Results, report.From, report.GeneratedAt)
+		if len(results) > 0 {
+			var total time.Duration
+			for _, result := range results {
+				total += result.Elapsed
+			}
+			endpoint.AverageResponseTime = total / time.Duration(len(results))
+		}
+		for _, incident := range Incidents(status.Results, options.Incidents) {
+			if !incident.End.Before(report.From) {
+				endpoint.Incidents = append(endpoint.Incidents, incident)
+			}
+		}
+		group := &report.Groups[len(report.Groups)-1]
+		group.Endpoints = append(group.Endpoints, endpoint)
+	}
+	return report
+}
+
+// ReportTemplate is a template that can render a Report, such as a *text/template.Template or an
+// *html/template.Template.
+type ReportTemplate interface {
+	// Execute applies the template to data, writing the output to w.
+	Execute(w io.Writer, data any) error
+}
+
+// ReportTemplateFuncs returns the functions available to DefaultReportTemplate, to be added to custom report
+// templates with Funcs. Since html/template.FuncMap is an alias of text/template.FuncMap, they can be added to
+// either kind of template:
+//
+//	percent       formats an uptime percentage, e.g. "99.95%"
+//	milliseconds  formats a duration in milliseconds, e.g. "120ms"
+//	join          strings.Join
+func ReportTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"percent": func(uptime float64) string {
+			return fmt.Sprintf("%.2f%%", uptime)
+		},
+		"milliseconds": func(d time.Duration) string {
+			return fmt.Sprintf("%dms", d.Milliseconds())
+		},
+		"join": strings.Join,
+	}
+}
+
+// Render executes tmpl with the report, writing the output to w.
+//
+// Example:
+//
+//	tmpl := template.Must(template.New("report").Funcs(ReportTemplateFuncs()).Parse(`
+//	<h1>Weekly report</h1>
+//	<ul>{{range .Groups}}{{range .Endpoints}}<li>{{.Key}}: {{percent .Uptime}}</li>{{end}}{{end}}</ul>`))
+//	err := report.Render(w, tmpl)
+func (r *Report) Render(w io.Writer, tmpl ReportTemplate) error {
+	if err := tmpl.Execute(w, r); err != nil {
+		return fmt.Errorf("rendering report: %w", err)
+	}
+	return nil
+}
+
+// defaultReportTemplate is the parsed DefaultReportTemplate.
+var defaultReportTemplate = template.Must(template.New("report").Funcs(ReportTemplateFuncs()).Parse(DefaultReportTemplate))
+
+// WriteMarkdown writes the report to w as Markdown, rendered with DefaultReportTemplate.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	return r.Render(w, defaultReportTemplate)
+}
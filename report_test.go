@@ -0,0 +1,126 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewReport(t *testing.T) {
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	old := newTimedResults(now.Add(-8*24*time.Hour), time.Second, time.Second)
+	old[0].Success = false
+	recent := newTimedResults(now.Add(-time.Hour), 100*time.Millisecond, 200*time.Millisecond, 300*time.Millisecond)
+	recent[1].Success = false
+	recent[1].Errors = []string{"timeout"}
+	snapshot := &Snapshot{
+		Timestamp: now,
+		Endpoints: []EndpointStatus{
+			{Key: "_external", Name: "external", Results: newTimedResults(now.Add(-time.Minute), 50*time.Millisecond)},
+			{Key: "core_api", Group: "core", Name: "api", Results: append(old, recent...)},
+			{Key: "core_db", Group: "core", Name: "db"},
+		},
+		Uptimes: map[string]map[Duration]float64{"core_api": {Duration7d: 99.5}, "_external": {Duration7d: 100}},
+	}
+	report := NewReport(snapshot, ReportOptions{})
+	if report.Duration != Duration7d || !report.From.Equal(now.Add(-7*24*time.Hour)) {
+		t.Errorf("expected a report of the last 7 days, got %s from %s", report.Duration, report.From)
+	}
+	if len(report.Groups) != 2 || report.Groups[0].Name != "core" || report.Groups[1].Name != "" {
+		t.Fatalf("expected the core group, then ungrouped endpoints, got %+v", report.Groups)
+	}
+	api := report.Groups[0].Endpoints[0]
+	if api.AverageResponseTime != 200*time.Millisecond || len(api.Incidents) != 1 || api.Incidents[0].ErrorSamples[0] != "timeout" {
+		t.Errorf("expected the response time and incidents of the period, got %+v", api)
+	}
+
+	var markdown strings.Builder
+	if err := report.WriteMarkdown(&markdown); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	expected := `# Status Report
+
+Generated: 2025-01-08T12:00:00Z
+
+Overall status: **operational** (2/3 endpoints healthy)
+
+## core
+
+| Endpoint | Health | Uptime (7d) | Avg. Response Time | Incidents |
+|----------|--------|--------|--------------------|-----------|
+| api | healthy | 99.50% | 200ms | 1 |
+| db | unknown | n/a | 0ms | 0 |
+
+## Ungrouped
+
+| Endpoint | Health | Uptime (7d) | Avg. Response Time | Incidents |
+|----------|--------|--------|--------------------|-----------|
+| external | healthy | 100.00% | 50ms | 0 |
+`
+	if markdown.String() != expected {
+		t.Errorf("got:\n%s\nwant:\n%s", markdown.String(), expected)
+	}
+}
+
+func TestReport_Render(t *testing.T) {
+	report := NewReport(&Snapshot{
+		Endpoints: []EndpointStatus{{Key: "core_<api>", Group: "core", Name: "<api>"}},
+		Uptimes:   map[string]map[Duration]float64{"core_<api>": {Duration24h: 99.9}},
+	}, ReportOptions{Duration: Duration24h})
+	tmpl := template.Must(template.New("report").Funcs(ReportTemplateFuncs()).Parse(
+		`<ul>{{range .Groups}}{{range .Endpoints}}<li>{{.Name}}: {{percent .Uptime}}</li>{{end}}{{end}}</ul>`,
+	))
+	var html strings.Builder
+	if err := report.Render(&html, tmpl); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if expected := `<ul><li>&lt;api&gt;: 99.90%</li></ul>`; html.String() != expected {
+		t.Errorf("got %s, want %s", html.String(), expected)
+	}
+
+	invalid := template.Must(template.New("report").Parse(`{{.Missing}}`))
+	if err := report.Render(&html, invalid); err == nil {
+		t.Error("expected error rendering a template using a missing field")
+	}
+}
+
+func TestClient_GenerateReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/endpoints/statuses":
+			w.Write([]byte(`[{"key": "core_api", "group": "core", "name": "api", "results": [{"success": true}]}]`))
+		case "/api/v1/endpoints/core_api/uptimes/30d":
+			w.Write([]byte(`0.995`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	report, err := NewClient(server.URL).GenerateReport(context.Background(), ReportOptions{Duration: Duration30d})
+	if err != nil {
+		t.Fatalf("GenerateReport() error = %v", err)
+	}
+	if len(report.Groups) != 1 || !report.Groups[0].Endpoints[0].HasUptime || report.Duration != Duration30d {
+		t.Errorf("expected the 30d uptime of core_api, got %+v", report)
+	}
+
+	// A missing uptime doesn't prevent the report from being generated
+	report, err = NewClient(server.URL).GenerateReport(context.Background(), ReportOptions{Duration: Duration24h})
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) || batchErr.Errors["core_api"] == nil {
+		t.Errorf("expected BatchError, got %v", err)
+	}
+	if report == nil || len(report.Groups) != 1 || report.Groups[0].Endpoints[0].HasUptime {
+		t.Errorf("expected the report without the uptime of core_api, got %+v", report)
+	}
+
+	if _, err := NewClient(server.URL+"/missing").GenerateReport(context.Background(), ReportOptions{}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
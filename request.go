@@ -0,0 +1,123 @@
+package gatussdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RequestOption is a function that configures a single request.
+type RequestOption func(*requestOptions)
+
+// requestOptions holds the per-request settings configured through RequestOption.
+type requestOptions struct {
+	timeout time.Duration
+	headers http.Header
+	query   url.Values
+
+	idempotent  bool
+	notModified *bool
+}
+
+// newRequestOptions applies the given options and returns the resulting settings.
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	options := &requestOptions{
+		headers: make(http.Header),
+		query:   make(url.Values),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithRequestTimeout sets a timeout for a single request, covering the time until the response body is fully read.
+// It is applied in addition to the client timeout and any deadline of the context passed to the method.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx, WithRequestTimeout(2*time.Second))
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithHeader sets a header on a single request, overriding any header set by the client.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx, WithHeader("X-Tenant", "acme"))
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.headers.Set(key, value)
+	}
+}
+
+// WithQueryParam adds a query parameter to a single request.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx, WithQueryParam("page", "2"))
+func WithQueryParam(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.query.Add(key, value)
+	}
+}
+
+// WithPage requests a specific page of results from the status endpoints, such as GetAllSuiteStatuses and
+// GetSuiteStatusByKey, allowing more results to be retrieved than the default window returned by Gatus.
+// Page 1 contains the most recent results. Values lower than 1 are ignored, leaving the Gatus default in place.
+//
+// Example:
+//
+//	// Retrieve the 50 suite executions preceding the 50 most recent ones
+//	status, err := client.GetSuiteStatusByKey(ctx, "_check-authentication", WithPage(2, 50))
+func WithPage(page, pageSize int) RequestOption {
+	return func(o *requestOptions) {
+		if page >= 1 {
+			o.query.Set("page", strconv.Itoa(page))
+		}
+		if pageSize >= 1 {
+			o.query.Set("pageSize", strconv.Itoa(pageSize))
+		}
+	}
+}
+
+// apply applies the request options to req, returning the request to send and a function releasing
+// the resources associated with the options, which must be called once the response body is no longer needed.
+func (o *requestOptions) apply(req *http.Request) (*http.Request, context.CancelFunc) {
+	for key, values := range o.headers {
+		req.Header[key] = values
+	}
+	if len(o.query) > 0 {
+		query := req.URL.Query()
+		for key, values := range o.query {
+			for _, value := range values {
+				query.Add(key, value)
+			}
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+	if o.timeout <= 0 {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), o.timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelOnCloseBody is a response body that releases the request context when closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the underlying body and cancels the request context.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,128 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestOptions(t *testing.T) {
+	t.Run("query parameters and headers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") != "2" {
+				t.Errorf("page = %v, want 2", r.URL.Query().Get("page"))
+			}
+			if r.Header.Get("X-Tenant") != "acme" {
+				t.Errorf("X-Tenant = %v, want acme", r.Header.Get("X-Tenant"))
+			}
+			if r.Header.Get("User-Agent") != "Override/1.0" {
+				t.Errorf("User-Agent = %v, want Override/1.0", r.Header.Get("User-Agent"))
+			}
+			w.Write([]byte("[]"))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL)
+		_, err := client.GetAllEndpointStatuses(context.Background(),
+			WithQueryParam("page", "2"),
+			WithHeader("X-Tenant", "acme"),
+			WithHeader("User-Agent", "Override/1.0"),
+		)
+		if err != nil {
+			t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+		}
+	})
+
+	t.Run("query parameters merged with existing ones", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("success") != "true" || r.URL.Query().Get("extra") != "1" {
+				t.Errorf("unexpected query: %v", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL)
+		if err := client.PushExternalEndpointResult(context.Background(), "core_ext", "token", true, "", "", WithQueryParam("extra", "1")); err != nil {
+			t.Fatalf("PushExternalEndpointResult() error = %v", err)
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		var queries []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			w.Write([]byte(`{"key":"_check-authentication"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL)
+		for _, opt := range []RequestOption{WithPage(2, 50), WithPage(3, 0), WithPage(0, 0)} {
+			if _, err := client.GetSuiteStatusByKey(context.Background(), "_check-authentication", opt); err != nil {
+				t.Fatalf("GetSuiteStatusByKey() error = %v", err)
+			}
+		}
+		expected := []string{"page=2&pageSize=50", "page=3", ""}
+		for i := range expected {
+			if queries[i] != expected[i] {
+				t.Errorf("query = %q, want %q", queries[i], expected[i])
+			}
+		}
+	})
+
+	t.Run("request timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+			w.Write([]byte("[]"))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL)
+		_, err := client.GetAllSuiteStatuses(context.Background(), WithRequestTimeout(20*time.Millisecond))
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("default request timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(200 * time.Millisecond):
+			case <-r.Context().Done():
+			}
+			w.Write([]byte("[]"))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, WithDefaultRequestTimeout(20*time.Millisecond))
+		if _, err := client.GetAllSuiteStatuses(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+		// The timeout of a single request takes precedence
+		if _, err := client.GetAllSuiteStatuses(context.Background(), WithRequestTimeout(5*time.Second)); err != nil {
+			t.Errorf("GetAllSuiteStatuses() error = %v", err)
+		}
+	})
+
+	t.Run("request timeout does not cut off body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"name":"api","group":"core","key":"core_api"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL)
+		status, err := client.GetEndpointStatus(context.Background(), "core", "api", WithRequestTimeout(time.Second))
+		if err != nil {
+			t.Fatalf("GetEndpointStatus() error = %v", err)
+		}
+		if status.Key != "core_api" {
+			t.Errorf("Key = %v, want core_api", status.Key)
+		}
+	})
+}
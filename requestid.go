@@ -0,0 +1,63 @@
+package gatussdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDHeader is the header carrying the request ID, used to correlate SDK calls with proxy and server logs.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDKey is the context key under which the request ID is stored.
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx carrying the given request ID.
+// Requests made with the returned context send it in the X-Request-ID header,
+// which allows propagating the ID of an incoming request to the calls made to Gatus.
+//
+// Example:
+//
+//	ctx := gatus.ContextWithRequestID(r.Context(), r.Header.Get(gatus.RequestIDHeader))
+//	statuses, err := client.GetAllEndpointStatuses(ctx)
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// WithRequestID sets whether a request ID is generated for requests whose context does not carry one
+// (see ContextWithRequestID). Request IDs are sent in the X-Request-ID header, included in log output
+// and set on APIError.RequestID, so that SDK calls can be correlated with proxy and server logs.
+// Request IDs carried by the context are always sent. Defaults to false.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithRequestID(true))
+func WithRequestID(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.generateRequestIDs = enabled
+	}
+}
+
+// requestID returns the request ID to send with a request made with ctx, or an empty string if there is none.
+func (c *Client) requestID(ctx context.Context) string {
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		return requestID
+	}
+	if c.generateRequestIDs {
+		return newRequestID()
+	}
+	return ""
+}
+
+// newRequestID generates a random request ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,76 @@
+package gatussdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithRequestID(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(RequestIDHeader))
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	t.Run("disabled", func(t *testing.T) {
+		received = nil
+		client := NewClient(server.URL)
+		client.GetAllEndpointStatuses(context.Background())
+		if received[0] != "" {
+			t.Errorf("%s = %v, want none", RequestIDHeader, received[0])
+		}
+	})
+
+	t.Run("generated", func(t *testing.T) {
+		received = nil
+		client := NewClient(server.URL, WithRequestID(true), WithLogger(logger))
+		_, err := client.GetAllEndpointStatuses(context.Background())
+		client.GetAllEndpointStatuses(context.Background())
+		if len(received[0]) != 32 || received[0] == received[1] {
+			t.Errorf("expected unique generated request IDs, got %v", received)
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.RequestID != received[0] {
+			t.Fatalf("expected APIError with request ID %s, got %v", received[0], err)
+		}
+		if !strings.Contains(apiErr.Error(), "request ID "+received[0]) {
+			t.Errorf("expected error message to contain request ID, got %v", apiErr.Error())
+		}
+		if !strings.Contains(buffer.String(), "request_id="+received[0]) {
+			t.Errorf("expected log output to contain request ID, got:\n%s", buffer.String())
+		}
+	})
+
+	t.Run("from context", func(t *testing.T) {
+		received = nil
+		ctx := ContextWithRequestID(context.Background(), "incoming-123")
+		for _, client := range []*Client{NewClient(server.URL), NewClient(server.URL, WithRequestID(true))} {
+			client.GetAllEndpointStatuses(ctx)
+		}
+		if received[0] != "incoming-123" || received[1] != "incoming-123" {
+			t.Errorf("expected request ID from context, got %v", received)
+		}
+	})
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	if _, ok := RequestIDFromContext(context.Background()); ok {
+		t.Error("expected no request ID")
+	}
+	if _, ok := RequestIDFromContext(ContextWithRequestID(context.Background(), "")); ok {
+		t.Error("expected empty request ID to be ignored")
+	}
+	if requestID, ok := RequestIDFromContext(ContextWithRequestID(context.Background(), "abc")); !ok || requestID != "abc" {
+		t.Errorf("RequestIDFromContext() = %v, %v, want abc, true", requestID, ok)
+	}
+}
@@ -0,0 +1,69 @@
+package gatussdk
+
+import (
+	"slices"
+	"time"
+)
+
+// MergeResults merges two sets of results, such as a snapshot and a backfill, or a backfill and the results
+// emitted by a watcher, into a single chronologically ordered slice without duplicates. Results are considered
+// duplicates if they share the same timestamp, in which case the one from a is kept. Neither a nor b is modified,
+// and they don't need to be sorted.
+//
+// Example:
+//
+//	history, err := client.GetEndpointHistory(ctx, "core_api", 0)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	results := MergeResults(snapshot.Endpoints[0].Results, history)
+func MergeResults(a, b []EndpointResult) []EndpointResult {
+	merged := make([]EndpointResult, 0, len(a)+len(b))
+	merged = append(append(merged, a...), b...)
+	// The sort is stable, so that the result from a comes first among results sharing the same timestamp
+	slices.SortStableFunc(merged, func(x, y EndpointResult) int {
+		return x.Timestamp.Compare(y.Timestamp)
+	})
+	return slices.CompactFunc(merged, func(x, y EndpointResult) bool {
+		return x.Timestamp.Equal(y.Timestamp)
+	})
+}
+
+// FilterResultsBetween returns the results whose timestamp is at or after from and strictly before to, preserving
+// their order. The range is half-open so that consecutive ranges, such as the days of a report, never count the
+// same result twice. A zero from or to leaves the range unbounded on that side. results is not modified.
+//
+// Example:
+//
+//	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+//	results := FilterResultsBetween(status.Results, day, day.AddDate(0, 0, 1))
+func FilterResultsBetween(results []EndpointResult, from, to time.Time) []EndpointResult {
+	var filtered []EndpointResult
+	for _, result := range results {
+		if !from.IsZero() && result.Timestamp.Before(from) {
+			continue
+		}
+		if !to.IsZero() && !result.Timestamp.Before(to) {
+			continue
+		}
+		filtered = append(filtered, result)
+	}
+	return filtered
+}
+
+// FilterResultsWithin returns the results of the last window before now: those whose timestamp is strictly after
+// now minus window, and at or before now. Results more recent than now are excluded. results is not modified.
+//
+// Example:
+//
+//	lastDay := FilterResultsWithin(status.Results, 24*time.Hour, time.Now())
+func FilterResultsWithin(results []EndpointResult, window time.Duration, now time.Time) []EndpointResult {
+	start := now.Add(-window)
+	var filtered []EndpointResult
+	for _, result := range results {
+		if result.Timestamp.After(start) && !result.Timestamp.After(now) {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
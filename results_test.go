@@ -0,0 +1,108 @@
+package gatussdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMergeResults(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int, success bool) EndpointResult {
+		return EndpointResult{Timestamp: start.Add(time.Duration(minutes) * time.Minute), Success: success}
+	}
+	tests := []struct {
+		name     string
+		a        []EndpointResult
+		b        []EndpointResult
+		expected []EndpointResult
+	}{
+		{
+			name:     "empty",
+			expected: []EndpointResult{},
+		},
+		{
+			name:     "overlapping",
+			a:        []EndpointResult{at(0, true), at(1, true), at(2, true)},
+			b:        []EndpointResult{at(1, false), at(2, false), at(3, false)},
+			expected: []EndpointResult{at(0, true), at(1, true), at(2, true), at(3, false)},
+		},
+		{
+			name:     "interleaved and unsorted",
+			a:        []EndpointResult{at(4, true), at(0, true)},
+			b:        []EndpointResult{at(3, false), at(1, false), at(3, false)},
+			expected: []EndpointResult{at(0, true), at(1, false), at(3, false), at(4, true)},
+		},
+		{
+			name:     "same instant in different locations",
+			a:        []EndpointResult{at(0, true)},
+			b:        []EndpointResult{{Timestamp: start.In(time.FixedZone("EST", -5*3600))}},
+			expected: []EndpointResult{at(0, true)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := MergeResults(tt.a, tt.b)
+			if len(actual) != len(tt.expected) {
+				t.Fatalf("got %d results, want %d: %+v", len(actual), len(tt.expected), actual)
+			}
+			for i := range actual {
+				if !actual[i].Timestamp.Equal(tt.expected[i].Timestamp) || actual[i].Success != tt.expected[i].Success {
+					t.Errorf("result %d = %+v, want %+v", i, actual[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFilterResultsBetween(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	results := newTimedResults(start, 0, 0, 0, 0, 0)
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		expected int
+	}{
+		{name: "unbounded", expected: 5},
+		{name: "from is inclusive", from: start.Add(time.Minute), expected: 4},
+		{name: "to is exclusive", to: start.Add(time.Minute), expected: 1},
+		{name: "between", from: start.Add(time.Minute), to: start.Add(3 * time.Minute), expected: 2},
+		{name: "empty range", from: start.Add(time.Minute), to: start.Add(time.Minute), expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := FilterResultsBetween(results, tt.from, tt.to); len(actual) != tt.expected {
+				t.Errorf("got %d results, want %d", len(actual), tt.expected)
+			}
+		})
+	}
+
+	// Consecutive ranges never count the same result twice
+	first := FilterResultsBetween(results, start, start.Add(2*time.Minute))
+	second := FilterResultsBetween(results, start.Add(2*time.Minute), start.Add(5*time.Minute))
+	if len(first)+len(second) != len(results) {
+		t.Errorf("expected consecutive ranges to partition the results, got %d and %d", len(first), len(second))
+	}
+}
+
+func TestFilterResultsWithin(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	results := newTimedResults(start, 0, 0, 0, 0, 0)
+	tests := []struct {
+		name     string
+		window   time.Duration
+		now      time.Time
+		expected int
+	}{
+		{name: "start is exclusive", window: 2 * time.Minute, now: start.Add(4 * time.Minute), expected: 2},
+		{name: "now is inclusive", window: time.Hour, now: start.Add(2 * time.Minute), expected: 3},
+		{name: "before every result", window: time.Hour, now: start.Add(-time.Minute), expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := FilterResultsWithin(results, tt.window, tt.now); len(actual) != tt.expected {
+				t.Errorf("got %d results, want %d", len(actual), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries of DefaultRetryPolicy.
+	DefaultMaxRetries = 3
+	// DefaultInitialBackoff is the delay before the first retry of DefaultRetryPolicy.
+	DefaultInitialBackoff = 200 * time.Millisecond
+	// DefaultMaxBackoff is the maximum delay between retries of DefaultRetryPolicy.
+	DefaultMaxBackoff = 5 * time.Second
+)
+
+// RetryPolicy configures how failed requests are retried.
+//
+// Requests are retried when they fail with a retryable error (see IsRetryable) or receive a temporary
+// error response (see APIError.Temporary). The delay between retries doubles after each retry, starting at
+// InitialBackoff and capped at MaxBackoff, with random jitter. If the server asks to wait longer than
+// MaxBackoff through the Retry-After header, the response is returned instead of being retried.
+//
+// Only idempotent requests are retried by default: GET, HEAD and OPTIONS requests, and requests made with
+// WithIdempotent. Requests pushing external endpoint results are not idempotent, since retrying a push whose
+// response was lost may record the same result twice.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries per request. Zero disables retries.
+	MaxRetries int `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty"`
+	// InitialBackoff is the delay before the first retry. If zero, DefaultInitialBackoff is used.
+	InitialBackoff time.Duration `json:"initialBackoff,omitempty" yaml:"initialBackoff,omitempty"`
+	// MaxBackoff is the maximum delay between retries. If zero, DefaultMaxBackoff is used.
+	MaxBackoff time.Duration `json:"maxBackoff,omitempty" yaml:"maxBackoff,omitempty"`
+	// RetryNonIdempotent opts into retrying every request, including external endpoint result pushes,
+	// accepting that a result may be recorded more than once.
+	RetryNonIdempotent bool `json:"retryNonIdempotent,omitempty" yaml:"retryNonIdempotent,omitempty"`
+}
+
+// DefaultRetryPolicy returns a retry policy retrying idempotent requests up to DefaultMaxRetries times.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     DefaultMaxRetries,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+	}
+}
+
+// UnmarshalJSON decodes a RetryPolicy, accepting durations either as Go duration strings (e.g. "200ms")
+// or as a number of nanoseconds.
+func (p *RetryPolicy) UnmarshalJSON(data []byte) error {
+	type alias RetryPolicy
+	aux := struct {
+		*alias
+		InitialBackoff any `json:"initialBackoff"`
+		MaxBackoff     any `json:"maxBackoff"`
+	}{alias: (*alias)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if p.InitialBackoff, err = parseConfigDuration("initialBackoff", aux.InitialBackoff, p.InitialBackoff); err != nil {
+		return err
+	}
+	if p.MaxBackoff, err = parseConfigDuration("maxBackoff", aux.MaxBackoff, p.MaxBackoff); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WithRetry sets the policy used to retry failed requests. By default, requests are not retried.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithRetry(DefaultRetryPolicy()))
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if policy.InitialBackoff <= 0 {
+			policy.InitialBackoff = DefaultInitialBackoff
+		}
+		if policy.MaxBackoff <= 0 {
+			policy.MaxBackoff = DefaultMaxBackoff
+		}
+		c.retryPolicy = policy
+	}
+}
+
+// WithIdempotent marks a single request as safe to retry even if its method is not idempotent,
+// such as a push whose duplicate would be harmless.
+//
+// Example:
+//
+//	err := client.PushExternalEndpointResult(ctx, "core_backup", token, true, "", "", WithIdempotent())
+func WithIdempotent() RequestOption {
+	return func(o *requestOptions) {
+		o.idempotent = true
+	}
+}
+
+// retryDelay returns how long to wait before retrying a request that resulted in resp or err,
+// and whether it should be retried at all, given the number of retries already performed.
+func (c *Client) retryDelay(req *http.Request, resp *http.Response, err error, retries int, idempotent bool) (time.Duration, bool) {
+	policy := c.retryPolicy
+	if retries >= policy.MaxRetries || !policy.allows(req, idempotent) {
+		return 0, false
+	}
+	delay := policy.backoff(retries)
+	if err != nil {
+		return delay, IsRetryable(err)
+	}
+	if !(&APIError{StatusCode: resp.StatusCode}).Temporary() {
+		return 0, false
+	}
+	if retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), c.clock.Now()); retryAfter > delay {
+		if retryAfter > policy.MaxBackoff {
+			return 0, false
+		}
+		delay = retryAfter
+	}
+	return delay, true
+}
+
+// allows returns whether the policy allows retrying req.
+func (p RetryPolicy) allows(req *http.Request, idempotent bool) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be sent again
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return idempotent || p.RetryNonIdempotent
+}
+
+// backoff returns the delay before the retry following the given number of retries,
+// with jitter of up to half the delay.
+func (p RetryPolicy) backoff(retries int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 0; i < retries && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.MaxBackoff)
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// prepareRetry releases resp, if any, waits for delay and rewinds the body of req.
+// It returns an error if ctx is done before the delay elapsed.
+func (c *Client) prepareRetry(ctx context.Context, req *http.Request, resp *http.Response, delay time.Duration) error {
+	if resp != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+	timer := c.clock.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		req.Body = body
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithRetry(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	scenarios := []struct {
+		name            string
+		policy          RetryPolicy
+		statuses        []int
+		retryAfter      string
+		push            bool
+		opts            []RequestOption
+		expectedCalls   int32
+		expectedRetries int
+		expectedErr     bool
+	}{
+		{
+			name:          "disabled by default",
+			statuses:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			expectedCalls: 1,
+			expectedErr:   true,
+		},
+		{
+			name:            "succeeds after transient errors",
+			policy:          policy,
+			statuses:        []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			expectedCalls:   3,
+			expectedRetries: 2,
+		},
+		{
+			name:            "gives up after max retries",
+			policy:          policy,
+			statuses:        []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			expectedCalls:   3,
+			expectedRetries: 2,
+			expectedErr:     true,
+		},
+		{
+			name:          "permanent error",
+			policy:        policy,
+			statuses:      []int{http.StatusNotFound, http.StatusOK},
+			expectedCalls: 1,
+			expectedErr:   true,
+		},
+		{
+			name:          "retry-after exceeding max backoff",
+			policy:        policy,
+			statuses:      []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:    "60",
+			expectedCalls: 1,
+			expectedErr:   true,
+		},
+		{
+			name:          "push is not retried",
+			policy:        policy,
+			statuses:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			push:          true,
+			expectedCalls: 1,
+			expectedErr:   true,
+		},
+		{
+			name:            "push marked idempotent",
+			policy:          policy,
+			statuses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			push:            true,
+			opts:            []RequestOption{WithIdempotent()},
+			expectedCalls:   2,
+			expectedRetries: 1,
+		},
+		{
+			name:            "push with non-idempotent retries",
+			policy:          RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond, RetryNonIdempotent: true},
+			statuses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			push:            true,
+			expectedCalls:   2,
+			expectedRetries: 1,
+		},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := scenario.statuses[calls.Add(1)-1]
+				if scenario.retryAfter != "" {
+					w.Header().Set("Retry-After", scenario.retryAfter)
+				}
+				w.WriteHeader(status)
+				if status == http.StatusOK && r.Method == http.MethodGet {
+					w.Write([]byte("[]"))
+				}
+			}))
+			defer server.Close()
+
+			var event MetricsEvent
+			client := NewClient(server.URL, WithRetry(scenario.policy), WithMetricsHook(func(e MetricsEvent) { event = e }))
+			var err error
+			if scenario.push {
+				err = client.PushExternalEndpointResult(context.Background(), "core_backup", "token", true, "", "", scenario.opts...)
+			} else {
+				_, err = client.GetAllEndpointStatuses(context.Background(), scenario.opts...)
+			}
+			if (err != nil) != scenario.expectedErr {
+				t.Errorf("error = %v, expectedErr %v", err, scenario.expectedErr)
+			}
+			if calls.Load() != scenario.expectedCalls {
+				t.Errorf("got %d calls, want %d", calls.Load(), scenario.expectedCalls)
+			}
+			if event.Retries != scenario.expectedRetries {
+				t.Errorf("Retries = %d, want %d", event.Retries, scenario.expectedRetries)
+			}
+		})
+	}
+}
+
+func TestWithRetry_ContextCanceledDuringBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithRetry(RetryPolicy{MaxRetries: 1, InitialBackoff: time.Minute, MaxBackoff: time.Minute}))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.GetAllEndpointStatuses(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Error("expected backoff to be interrupted by the context")
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for retries, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		if delay := policy.backoff(retries); delay < max/2 || delay > max {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", retries, delay, max/2, max)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package gatussdk
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// MatchingPattern returns a filter matching statuses whose key, name or group matches pattern.
+//
+// A pattern enclosed in slashes (e.g. "/^payments-(eu|us)$/") is a regular expression, which matches if it
+// matches any part of the field. Any other pattern is a glob (e.g. "payments-*"), in which "*" matches any
+// sequence of characters, "?" matches a single character and "[...]" matches a character class; it must match
+// the entire field. Matching is case-sensitive.
+func MatchingPattern(pattern string) (StatusFilter, error) {
+	match, err := compilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return func(status *EndpointStatus) bool {
+		return match(status.Key) || match(status.Name) || (status.Group != "" && match(status.Group))
+	}, nil
+}
+
+// compilePattern compiles a glob or regular expression pattern (see MatchingPattern) into a match function.
+func compilePattern(pattern string) (func(string) bool, error) {
+	if pattern == "" {
+		return nil, &ValidationError{Field: "pattern", Message: "cannot be empty"}
+	}
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		expression, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, &ValidationError{Field: "pattern", Message: "invalid regular expression: " + err.Error()}
+		}
+		return expression.MatchString, nil
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, &ValidationError{Field: "pattern", Message: "invalid glob: " + err.Error()}
+	}
+	return func(s string) bool {
+		matched, _ := path.Match(pattern, s)
+		return matched
+	}, nil
+}
+
+// FindEndpoints retrieves the status of every endpoint whose key, name or group matches pattern, which is either
+// a glob or a regular expression enclosed in slashes (see MatchingPattern). Statuses are returned in the order
+// Gatus returned them. An invalid pattern is reported as a *ValidationError without making a request.
+//
+// Example:
+//
+//	// Every payments endpoint, across all groups
+//	statuses, err := client.FindEndpoints(ctx, "payments-*")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// Endpoints of the eu-west and us-east groups
+//	statuses, err = client.FindEndpoints(ctx, "/^(eu-west|us-east)$/")
+func (c *Client) FindEndpoints(ctx context.Context, pattern string, opts ...RequestOption) ([]EndpointStatus, error) {
+	filter, err := MatchingPattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := c.GetAllEndpointStatuses(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return FilterStatuses(statuses, filter), nil
+}
+
+// GetUnhealthyEndpoints retrieves the status of every endpoint whose most recent result failed,
+// so that alert dashboards can make a single call instead of filtering every status.
+//
+// Example:
+//
+//	unhealthy, err := client.GetUnhealthyEndpoints(context.Background())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, status := range unhealthy {
+//	    fmt.Printf("%s is down: %v\n", status.Key, status.LastResult().Errors)
+//	}
+func (c *Client) GetUnhealthyEndpoints(ctx context.Context, opts ...RequestOption) ([]EndpointStatus, error) {
+	statuses, err := c.GetAllEndpointStatuses(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return FilterStatuses(statuses, Failing()), nil
+}
+
+// GetUnhealthyEndpointsInGroup retrieves the status of every endpoint of a group whose most recent result failed.
+// Use an empty group for endpoints without one.
+//
+// Example:
+//
+//	unhealthy, err := client.GetUnhealthyEndpointsInGroup(context.Background(), "core")
+func (c *Client) GetUnhealthyEndpointsInGroup(ctx context.Context, group string, opts ...RequestOption) ([]EndpointStatus, error) {
+	statuses, err := c.GetAllEndpointStatuses(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return FilterStatuses(statuses, Failing(), InGroup(group)), nil
+}
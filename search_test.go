@@ -0,0 +1,143 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestMatchingPattern(t *testing.T) {
+	statuses := []EndpointStatus{
+		{Key: "eu-west_payments-api", Group: "eu-west", Name: "payments-api"},
+		{Key: "us-east_payments-web", Group: "us-east", Name: "payments-web"},
+		{Key: "us-east_checkout", Group: "us-east", Name: "checkout"},
+		{Key: "_payments", Name: "payments"},
+	}
+	tests := []struct {
+		pattern       string
+		expected      []string
+		expectedError bool
+	}{
+		{pattern: "payments-*", expected: []string{"eu-west_payments-api", "us-east_payments-web"}},
+		{pattern: "payments*", expected: []string{"eu-west_payments-api", "us-east_payments-web", "_payments"}},
+		{pattern: "us-*", expected: []string{"us-east_payments-web", "us-east_checkout"}},
+		{pattern: "*_payments-api", expected: []string{"eu-west_payments-api"}},
+		{pattern: "payments-we?", expected: []string{"us-east_payments-web"}},
+		{pattern: "checkout", expected: []string{"us-east_checkout"}},
+		{pattern: "payments", expected: []string{"_payments"}},
+		{pattern: "/-(api|web)$/", expected: []string{"eu-west_payments-api", "us-east_payments-web"}},
+		{pattern: "/out/", expected: []string{"us-east_checkout"}},
+		{pattern: "missing", expected: []string{}},
+		{pattern: "", expectedError: true},
+		{pattern: "[", expectedError: true},
+		{pattern: "/(/", expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			filter, err := MatchingPattern(tt.pattern)
+			if tt.expectedError {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Field != "pattern" {
+					t.Errorf("expected validation error for pattern, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MatchingPattern() error = %v", err)
+			}
+			if keys := keysOf(FilterStatuses(statuses, filter)); !reflect.DeepEqual(keys, tt.expected) {
+				t.Errorf("got %v, want %v", keys, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClient_FindEndpoints(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode([]EndpointStatus{
+			{Key: "core_payments-api", Group: "core", Name: "payments-api"},
+			{Key: "core_web", Group: "core", Name: "web"},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	statuses, err := client.FindEndpoints(context.Background(), "payments-*")
+	if err != nil {
+		t.Fatalf("FindEndpoints() error = %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Key != "core_payments-api" {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+	if _, err := client.FindEndpoints(context.Background(), "["); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
+
+func TestClient_GetUnhealthyEndpoints(t *testing.T) {
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode([]EndpointStatus{
+			{Key: "core_api", Group: "core", Results: []EndpointResult{{Success: false}, {Success: true}}},
+			{Key: "core_db", Group: "core", Results: []EndpointResult{{Success: true}, {Success: false}}},
+			{Key: "edge_cdn", Group: "edge", Results: []EndpointResult{{Success: false}}},
+			{Key: "_standalone", Results: []EndpointResult{{Success: false}}},
+			{Key: "core_new", Group: "core"},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	tests := []struct {
+		name     string
+		get      func() ([]EndpointStatus, error)
+		expected []string
+	}{
+		{
+			name:     "all groups",
+			get:      func() ([]EndpointStatus, error) { return client.GetUnhealthyEndpoints(context.Background()) },
+			expected: []string{"core_db", "edge_cdn", "_standalone"},
+		},
+		{
+			name: "group",
+			get: func() ([]EndpointStatus, error) {
+				return client.GetUnhealthyEndpointsInGroup(context.Background(), "core")
+			},
+			expected: []string{"core_db"},
+		},
+		{
+			name:     "without group",
+			get:      func() ([]EndpointStatus, error) { return client.GetUnhealthyEndpointsInGroup(context.Background(), "") },
+			expected: []string{"_standalone"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses, err := tt.get()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual := keysOf(statuses); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("got %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+
+	failing = true
+	if _, err := client.GetUnhealthyEndpoints(context.Background()); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,86 @@
+package gatussdk
+
+// Severity represents how healthy an endpoint is, distinguishing degraded states from full outages.
+type Severity int
+
+const (
+	// SeverityUnknown means there is no result to assess.
+	SeverityUnknown Severity = iota
+	// SeverityHealthy means the health check succeeded and every condition was met.
+	SeverityHealthy
+	// SeverityDegraded means the health check succeeded even though some conditions were not met,
+	// or that it reported errors despite succeeding.
+	SeverityDegraded
+	// SeverityDown means the health check failed.
+	SeverityDown
+)
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityHealthy:
+		return "healthy"
+	case SeverityDegraded:
+		return "degraded"
+	case SeverityDown:
+		return "down"
+	}
+	return "unknown"
+}
+
+// MarshalText encodes the severity as its name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a severity from its name. Unrecognized names decode to SeverityUnknown.
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "healthy":
+		*s = SeverityHealthy
+	case "degraded":
+		*s = SeverityDegraded
+	case "down":
+		*s = SeverityDown
+	default:
+		*s = SeverityUnknown
+	}
+	return nil
+}
+
+// Severity returns the severity of the result.
+//
+// Example:
+//
+//	switch result.Severity() {
+//	case SeverityHealthy:
+//	    fmt.Println("🟢")
+//	case SeverityDegraded:
+//	    fmt.Println("🟡")
+//	case SeverityDown:
+//	    fmt.Println("🔴")
+//	}
+func (r *EndpointResult) Severity() Severity {
+	if !r.Success {
+		return SeverityDown
+	}
+	if len(r.Errors) > 0 {
+		return SeverityDegraded
+	}
+	for _, conditionResult := range r.ConditionResults {
+		if !conditionResult.Success {
+			return SeverityDegraded
+		}
+	}
+	return SeverityHealthy
+}
+
+// Severity returns the severity of the most recent result of the endpoint,
+// or SeverityUnknown if there are no results.
+func (s *EndpointStatus) Severity() Severity {
+	last := s.LastResult()
+	if last == nil {
+		return SeverityUnknown
+	}
+	return last.Severity()
+}
@@ -0,0 +1,80 @@
+package gatussdk
+
+import (
+	"testing"
+)
+
+func TestEndpointResult_Severity(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   EndpointResult
+		expected Severity
+	}{
+		{
+			name: "all conditions met",
+			result: EndpointResult{Success: true, ConditionResults: []ConditionResult{
+				{Condition: "[STATUS] == 200", Success: true},
+				{Condition: "[RESPONSE_TIME] < 500", Success: true},
+			}},
+			expected: SeverityHealthy,
+		},
+		{
+			name: "successful with failed condition",
+			result: EndpointResult{Success: true, ConditionResults: []ConditionResult{
+				{Condition: "[STATUS] == 200", Success: true},
+				{Condition: "[RESPONSE_TIME] < 500", Success: false},
+			}},
+			expected: SeverityDegraded,
+		},
+		{
+			name:     "successful with errors",
+			result:   EndpointResult{Success: true, Errors: []string{"slow response"}},
+			expected: SeverityDegraded,
+		},
+		{
+			name: "failed",
+			result: EndpointResult{Success: false, ConditionResults: []ConditionResult{
+				{Condition: "[STATUS] == 200", Success: false},
+			}},
+			expected: SeverityDown,
+		},
+		{
+			name:     "successful without conditions",
+			result:   EndpointResult{Success: true},
+			expected: SeverityHealthy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := tt.result.Severity(); actual != tt.expected {
+				t.Errorf("Severity() = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEndpointStatus_Severity(t *testing.T) {
+	status := EndpointStatus{}
+	if status.Severity() != SeverityUnknown {
+		t.Errorf("Severity() = %v, want unknown", status.Severity())
+	}
+	status.Results = []EndpointResult{{Success: false}, {Success: true}}
+	if status.Severity() != SeverityHealthy {
+		t.Errorf("Severity() = %v, want healthy (latest result)", status.Severity())
+	}
+}
+
+func TestSeverity_String(t *testing.T) {
+	expected := map[Severity]string{
+		SeverityUnknown:  "unknown",
+		SeverityHealthy:  "healthy",
+		SeverityDegraded: "degraded",
+		SeverityDown:     "down",
+		Severity(42):     "unknown",
+	}
+	for severity, name := range expected {
+		if severity.String() != name {
+			t.Errorf("String() = %v, want %v", severity.String(), name)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package gatussdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WithSingleflight enables the deduplication of identical concurrent GET requests: while a request is in flight,
+// identical requests wait for it and share its response instead of sending their own.
+// This is useful when many components, such as the widgets of a dashboard, fetch the same data at the same time.
+//
+// Requests are identical if they have the same method, URL (including the query string) and Authorization header.
+// Each caller decodes its own copy of the shared response, so results are never aliased between callers.
+// Shared responses are counted as cache hits in Stats.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithSingleflight(true))
+func WithSingleflight(enabled bool) ClientOption {
+	return func(c *Client) {
+		if enabled {
+			c.flights = &flightGroup{calls: make(map[string]*flightCall)}
+		} else {
+			c.flights = nil
+		}
+	}
+}
+
+// flightGroup tracks the requests in flight, keyed by flightKey.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall is a request in flight. Its fields are set before done is closed.
+type flightCall struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	info sendInfo
+	err  error
+}
+
+// send sends req using fetch, sharing the response of an identical request in flight if singleflight is enabled.
+func (c *Client) send(req *http.Request) (*http.Response, sendInfo, error) {
+	if c.flights == nil || req.Method != http.MethodGet {
+		return c.fetch(req)
+	}
+	resp, info, err := c.flights.do(req, c.fetch, c.readBody)
+	if info.shared {
+		c.stats.cacheHits.Add(1)
+	}
+	return resp, info, err
+}
+
+// do sends req using send and reads the response with readBody, unless an identical request is in flight, in which
+// case it waits for its response.
+func (g *flightGroup) do(req *http.Request, send func(*http.Request) (*http.Response, sendInfo, error), readBody func(*http.Response) ([]byte, error)) (*http.Response, sendInfo, error) {
+	key := requestKey(req)
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, sendInfo{}, req.Context().Err()
+		}
+		if call.err != nil {
+			// Don't fail because the caller that sent the request gave up on it
+			if errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded) {
+				return send(req)
+			}
+			return nil, sendInfo{}, call.err
+		}
+		return call.response(req), sendInfo{shared: true, notModified: call.info.notModified}, nil
+	}
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.resp, call.info, call.err = send(req)
+	if call.err == nil {
+		call.body, call.err = readBody(call.resp)
+	}
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+	if call.err != nil {
+		return nil, sendInfo{}, call.err
+	}
+	return call.response(req), call.info, nil
+}
+
+// response returns a copy of the shared response for req, with its own body.
+func (call *flightCall) response(req *http.Request) *http.Response {
+	resp := *call.resp
+	resp.Header = call.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(call.body))
+	resp.Request = req
+	return &resp
+}
+
+// requestKey returns the key identifying requests that can share a response.
+func requestKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String() + " " + req.Header.Get("Authorization")
+}
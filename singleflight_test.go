@@ -0,0 +1,128 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithSingleflight(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path == "/api/v1/endpoints/statuses" {
+			<-release
+		}
+		json.NewEncoder(w).Encode([]EndpointStatus{{Key: "core_api"}})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithSingleflight(true))
+	const callers = 10
+	results := make([][]EndpointStatus, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses, err := client.GetAllEndpointStatuses(context.Background())
+			if err != nil {
+				t.Errorf("GetAllEndpointStatuses() error = %v", err)
+			}
+			results[i] = statuses
+		}()
+	}
+	// Give every caller time to join the request in flight
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("got %d requests, want 1", calls.Load())
+	}
+	for i, statuses := range results {
+		if len(statuses) != 1 || statuses[0].Key != "core_api" {
+			t.Errorf("caller %d got %+v", i, statuses)
+		}
+	}
+	// Results must not be aliased between callers
+	results[0][0].Key = "modified"
+	if results[1][0].Key != "core_api" {
+		t.Error("expected each caller to decode its own copy")
+	}
+	if stats := client.Stats(); stats.CacheHits != callers-1 {
+		t.Errorf("CacheHits = %d, want %d", stats.CacheHits, callers-1)
+	}
+
+	// Requests that are not in flight at the same time are not deduplicated
+	calls.Store(0)
+	for i := 0; i < 2; i++ {
+		client.GetEndpointStatusByKey(context.Background(), "core_api")
+	}
+	if calls.Load() != 2 {
+		t.Errorf("got %d requests, want 2", calls.Load())
+	}
+}
+
+func TestWithSingleflight_LeaderCanceled(t *testing.T) {
+	started := make(chan struct{})
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithSingleflight(true))
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderDone := make(chan struct{})
+	go func() {
+		defer close(leaderDone)
+		client.GetAllEndpointStatuses(ctx)
+	}()
+	<-started
+	followerErr := make(chan error)
+	go func() {
+		_, err := client.GetAllEndpointStatuses(context.Background())
+		followerErr <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-leaderDone
+	if err := <-followerErr; err != nil {
+		t.Errorf("expected follower to send its own request, got %v", err)
+	}
+}
+
+func TestWithSingleflight_MaxResponseSize(t *testing.T) {
+	// The server streams an endless body, so reading it whole would never end
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chunk := make([]byte, 32*1024)
+		for r.Context().Err() == nil {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithSingleflight(true), WithMaxResponseSize(1024))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := client.GetAllEndpointStatuses(ctx)
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Errorf("expected ResponseTooLargeError, got %v", err)
+	}
+}
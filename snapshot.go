@@ -0,0 +1,162 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Snapshot is a frozen copy of the state of a Gatus instance, allowing tools and tests to work offline.
+type Snapshot struct {
+	// Timestamp is when the snapshot was taken.
+	Timestamp time.Time `json:"timestamp"`
+	// Endpoints contains the status of every endpoint.
+	Endpoints []EndpointStatus `json:"endpoints"`
+	// Suites contains the status of every suite.
+	Suites []SuiteStatus `json:"suites"`
+	// Uptimes contains the uptime percentage of each endpoint, by endpoint key and duration.
+	Uptimes map[string]map[Duration]float64 `json:"uptimes,omitempty"`
+}
+
+// TakeSnapshot retrieves the status of every endpoint and suite, as well as the uptime of every endpoint
+// for each of the given durations. Uptimes are retrieved concurrently (see GetUptimes).
+// Instances running a version of Gatus without suites are snapshotted without suites.
+//
+// If the uptime of some endpoints could not be retrieved, it is missing from the snapshot, and a *BatchError
+// containing the error for each of these endpoints is returned alongside the snapshot.
+//
+// Example:
+//
+//	snapshot, err := client.TakeSnapshot(context.Background(), Duration24h, Duration7d)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	file, _ := os.Create("snapshot.json")
+//	defer file.Close()
+//	err = snapshot.Save(file)
+func (c *Client) TakeSnapshot(ctx context.Context, durations ...Duration) (*Snapshot, error) {
+	snapshot := &Snapshot{Timestamp: c.clock.Now()}
+	var err error
+	if snapshot.Endpoints, err = c.GetAllEndpointStatuses(ctx); err != nil {
+		return nil, fmt.Errorf("retrieving endpoint statuses: %w", err)
+	}
+	if snapshot.Suites, err = c.GetAllSuiteStatuses(ctx); err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("retrieving suite statuses: %w", err)
+	}
+	if len(durations) == 0 {
+		return snapshot, nil
+	}
+	keys := make([]string, len(snapshot.Endpoints))
+	for i, status := range snapshot.Endpoints {
+		keys[i] = status.Key
+	}
+	snapshot.Uptimes = make(map[string]map[Duration]float64, len(keys))
+	errs := make(map[string]error)
+	for _, duration := range durations {
+		uptimes, err := c.GetUptimes(ctx, keys, string(duration))
+		var batchErr *BatchError
+		if errors.As(err, &batchErr) {
+			for key, err := range batchErr.Errors {
+				err = fmt.Errorf("retrieving %s uptime: %w", duration, err)
+				if previous, ok := errs[key]; ok {
+					err = errors.Join(previous, err)
+				}
+				errs[key] = err
+			}
+		}
+		for key, uptime := range uptimes {
+			if snapshot.Uptimes[key] == nil {
+				snapshot.Uptimes[key] = make(map[Duration]float64, len(durations))
+			}
+			snapshot.Uptimes[key][duration] = uptime
+		}
+	}
+	if len(errs) > 0 {
+		return snapshot, &BatchError{Errors: errs}
+	}
+	return snapshot, nil
+}
+
+// SnapshotVersion is the version of the format written by Snapshot.Save. It is increased whenever the format
+// changes in a way that older versions of the SDK cannot read.
+const SnapshotVersion = 1
+
+// ErrUnsupportedSnapshotVersion is returned by LoadSnapshot when a snapshot was written in a format more recent
+// than SnapshotVersion, typically by a newer version of the SDK.
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported snapshot version")
+
+// SnapshotEnvelope is the versioned format in which snapshots are saved, allowing snapshots written by one version
+// of the SDK to be read by the next.
+type SnapshotEnvelope struct {
+	// Version is the version of the format of the snapshot (see SnapshotVersion).
+	Version int `json:"version"`
+	// Snapshot is the saved snapshot.
+	Snapshot *Snapshot `json:"snapshot"`
+}
+
+// Save writes the snapshot to w as JSON, wrapped in a SnapshotEnvelope.
+func (s *Snapshot) Save(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(SnapshotEnvelope{Version: SnapshotVersion, Snapshot: s}); err != nil {
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot reads a snapshot written by Snapshot.Save from r, including snapshots written by previous versions
+// of the SDK. Snapshots written in a more recent format return an error wrapping ErrUnsupportedSnapshotVersion.
+//
+// Example:
+//
+//	file, err := os.Open("snapshot.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer file.Close()
+//	snapshot, err := LoadSnapshot(file)
+func LoadSnapshot(r io.Reader) (*Snapshot, error) {
+	var data json.RawMessage
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decoding snapshot: %w", err)
+	}
+	var envelope struct {
+		Version  int             `json:"version"`
+		Snapshot json.RawMessage `json:"snapshot"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("decoding snapshot: %w", err)
+	}
+	if envelope.Version > SnapshotVersion {
+		return nil, fmt.Errorf("%w: %d, expected at most %d", ErrUnsupportedSnapshotVersion, envelope.Version, SnapshotVersion)
+	}
+	if envelope.Snapshot != nil {
+		data = envelope.Snapshot
+	}
+	// Snapshots saved before versioning was introduced are not wrapped in an envelope
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("decoding snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// Endpoint returns the status of the endpoint with the given key, or nil if the snapshot doesn't contain it.
+func (s *Snapshot) Endpoint(key string) *EndpointStatus {
+	for i := range s.Endpoints {
+		if s.Endpoints[i].Key == key {
+			return &s.Endpoints[i]
+		}
+	}
+	return nil
+}
+
+// Uptime returns the uptime of the endpoint with the given key for the given duration,
+// and whether the snapshot contains it.
+func (s *Snapshot) Uptime(key string, duration Duration) (float64, bool) {
+	uptime, ok := s.Uptimes[key][duration]
+	return uptime, ok
+}
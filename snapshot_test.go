@@ -0,0 +1,124 @@
+package gatussdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_TakeSnapshot_WithoutSuites(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/endpoints/statuses":
+			w.Write([]byte(`[{"key": "core_api"}]`))
+		case "/api/v1/endpoints/core_api/uptimes/7d":
+			w.Write([]byte(`0.98`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	snapshot, err := NewClient(server.URL).TakeSnapshot(context.Background(), Duration7d)
+	if err != nil {
+		t.Fatalf("TakeSnapshot() error = %v", err)
+	}
+	if snapshot.Endpoint("core_api") == nil || snapshot.Endpoint("core_web") != nil {
+		t.Errorf("unexpected endpoints: %+v", snapshot.Endpoints)
+	}
+	if len(snapshot.Suites) != 0 {
+		t.Errorf("expected no suites, got %+v", snapshot.Suites)
+	}
+	if _, ok := snapshot.Uptime("core_api", Duration7d); !ok {
+		t.Errorf("expected 7d uptime, got %+v", snapshot.Uptimes)
+	}
+}
+
+func TestClient_TakeSnapshot_UptimeErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/endpoints/statuses":
+			w.Write([]byte(`[{"key": "core_api"}, {"key": "core_db"}]`))
+		case "/api/v1/suites/statuses":
+			w.Write([]byte(`[]`))
+		case "/api/v1/endpoints/core_api/uptimes/24h", "/api/v1/endpoints/core_api/uptimes/7d":
+			w.Write([]byte(`0.98`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	snapshot, err := NewClient(server.URL).TakeSnapshot(context.Background(), Duration24h, Duration7d)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected BatchError, got %v", err)
+	}
+	if len(batchErr.Errors) != 1 || !strings.Contains(batchErr.Errors["core_db"].Error(), "retrieving 7d uptime") {
+		t.Errorf("expected the uptime errors of core_db, got %v", batchErr.Errors)
+	}
+	if snapshot == nil || len(snapshot.Endpoints) != 2 {
+		t.Fatalf("expected the snapshot alongside the error, got %+v", snapshot)
+	}
+	if _, ok := snapshot.Uptime("core_api", Duration7d); !ok {
+		t.Errorf("expected the 7d uptime of core_api, got %+v", snapshot.Uptimes)
+	}
+	if _, ok := snapshot.Uptime("core_db", Duration24h); ok {
+		t.Errorf("expected the uptime of core_db to be missing, got %+v", snapshot.Uptimes)
+	}
+}
+
+func TestLoadSnapshot_Invalid(t *testing.T) {
+	if _, err := LoadSnapshot(strings.NewReader("{")); err == nil {
+		t.Error("expected error for invalid snapshot")
+	}
+}
+
+func TestSnapshot_SaveAndLoad(t *testing.T) {
+	timestamp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	snapshot := &Snapshot{
+		Timestamp: timestamp,
+		Endpoints: []EndpointStatus{{Key: "core_api", Results: []EndpointResult{{Success: true, Timestamp: timestamp, Duration: 5, Elapsed: 5}}}},
+		Suites:    []SuiteStatus{{Key: "_check", Results: []SuiteResult{{Success: true, Timestamp: timestamp}}}},
+		Uptimes:   map[string]map[Duration]float64{"core_api": {Duration24h: 99.5}},
+	}
+	var buf bytes.Buffer
+	if err := snapshot.Save(&buf); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `"version": 1`) {
+		t.Errorf("expected a versioned envelope, got %s", buf.String())
+	}
+	loaded, err := LoadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded, snapshot) {
+		t.Errorf("round trip mismatch:\ngot  %+v\nwant %+v", loaded, snapshot)
+	}
+}
+
+func TestLoadSnapshot_Versions(t *testing.T) {
+	t.Run("unversioned", func(t *testing.T) {
+		// Snapshots saved before versioning was introduced
+		snapshot, err := LoadSnapshot(strings.NewReader(`{"timestamp":"2025-01-01T12:00:00Z","endpoints":[{"key":"core_api","results":[]}],"suites":[]}`))
+		if err != nil {
+			t.Fatalf("LoadSnapshot() error = %v", err)
+		}
+		if snapshot.Endpoint("core_api") == nil {
+			t.Errorf("unexpected snapshot: %+v", snapshot)
+		}
+	})
+	t.Run("more recent", func(t *testing.T) {
+		_, err := LoadSnapshot(strings.NewReader(`{"version":2,"snapshot":{}}`))
+		if !errors.Is(err, ErrUnsupportedSnapshotVersion) {
+			t.Errorf("expected ErrUnsupportedSnapshotVersion, got %v", err)
+		}
+	})
+}
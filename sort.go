@@ -0,0 +1,96 @@
+package gatussdk
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// StatusOrder compares two endpoint statuses, returning a negative number if a sorts before b,
+// a positive number if a sorts after b, and zero if their order is not determined.
+type StatusOrder func(a, b *EndpointStatus) int
+
+var (
+	// ByGroupThenName orders statuses alphabetically by group, then by name.
+	// Endpoints without a group sort after every grouped endpoint.
+	ByGroupThenName StatusOrder = compareGroupThenName
+	// ByHealth orders statuses from the least to the most healthy, based on their most recent result:
+	// down, degraded, unknown, then healthy.
+	ByHealth StatusOrder = compareHealth
+	// ByLastCheck orders statuses from the most to the least recently checked.
+	// Endpoints without results sort last.
+	ByLastCheck StatusOrder = compareLastCheck
+)
+
+// SortStatuses sorts statuses in place using the given orders. When an order considers two statuses equal,
+// the next order is used to break the tie, and statuses that all orders consider equal keep their relative order.
+// Without any order, statuses are sorted by group, then by name.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	// Show failing endpoints first, and endpoints of the same health by group and name
+//	SortStatuses(statuses, ByHealth, ByGroupThenName)
+func SortStatuses(statuses []EndpointStatus, orders ...StatusOrder) {
+	if len(orders) == 0 {
+		orders = []StatusOrder{ByGroupThenName}
+	}
+	slices.SortStableFunc(statuses, func(a, b EndpointStatus) int {
+		for _, order := range orders {
+			if c := order(&a, &b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+func compareGroupThenName(a, b *EndpointStatus) int {
+	if (a.Group == "") != (b.Group == "") {
+		if a.Group == "" {
+			return 1
+		}
+		return -1
+	}
+	return cmp.Or(cmp.Compare(a.Group, b.Group), cmp.Compare(a.Name, b.Name))
+}
+
+// healthRank maps each severity to its position when ordering by health.
+var healthRank = map[Severity]int{
+	SeverityDown:     0,
+	SeverityDegraded: 1,
+	SeverityUnknown:  2,
+	SeverityHealthy:  3,
+}
+
+func compareHealth(a, b *EndpointStatus) int {
+	return cmp.Compare(healthRank[a.Severity()], healthRank[b.Severity()])
+}
+
+func compareLastCheck(a, b *EndpointStatus) int {
+	lastA, lastB := lastCheck(a), lastCheck(b)
+	if lastA.IsZero() || lastB.IsZero() {
+		// Statuses without results sort last
+		return cmp.Compare(boolRank(lastA.IsZero()), boolRank(lastB.IsZero()))
+	}
+	return lastB.Compare(lastA)
+}
+
+// lastCheck returns the timestamp of the most recent result of the status, or the zero time if there is none.
+func lastCheck(status *EndpointStatus) time.Time {
+	last := status.LastResult()
+	if last == nil {
+		return time.Time{}
+	}
+	return last.Timestamp
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
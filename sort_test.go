@@ -0,0 +1,66 @@
+package gatussdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSortStatuses(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	statuses := []EndpointStatus{
+		{Key: "_standalone", Name: "standalone", Results: []EndpointResult{{Success: true, Timestamp: now.Add(-time.Minute)}}},
+		{Key: "core_web", Group: "core", Name: "web", Results: []EndpointResult{{Success: false, Timestamp: now.Add(-2 * time.Minute)}}},
+		{Key: "_new", Name: "new"},
+		{Key: "core_api", Group: "core", Name: "api", Results: []EndpointResult{{Success: true, Timestamp: now}}},
+		{Key: "auth_login", Group: "auth", Name: "login", Results: []EndpointResult{{Success: true, Errors: []string{"slow"}, Timestamp: now.Add(-3 * time.Minute)}}},
+	}
+	tests := []struct {
+		name     string
+		orders   []StatusOrder
+		expected []string
+	}{
+		{
+			name:     "default",
+			expected: []string{"auth_login", "core_api", "core_web", "_new", "_standalone"},
+		},
+		{
+			name:     "by group then name",
+			orders:   []StatusOrder{ByGroupThenName},
+			expected: []string{"auth_login", "core_api", "core_web", "_new", "_standalone"},
+		},
+		{
+			name:     "by health",
+			orders:   []StatusOrder{ByHealth},
+			expected: []string{"core_web", "auth_login", "_new", "_standalone", "core_api"},
+		},
+		{
+			name:     "by health then group and name",
+			orders:   []StatusOrder{ByHealth, ByGroupThenName},
+			expected: []string{"core_web", "auth_login", "_new", "core_api", "_standalone"},
+		},
+		{
+			name:     "by last check",
+			orders:   []StatusOrder{ByLastCheck},
+			expected: []string{"core_api", "_standalone", "core_web", "auth_login", "_new"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := append([]EndpointStatus(nil), statuses...)
+			SortStatuses(sorted, tt.orders...)
+			for i, status := range sorted {
+				if status.Key != tt.expected[i] {
+					t.Fatalf("got %v, want %v", keysOf(sorted), tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func keysOf(statuses []EndpointStatus) []string {
+	keys := make([]string, len(statuses))
+	for i, status := range statuses {
+		keys[i] = status.Key
+	}
+	return keys
+}
@@ -0,0 +1,56 @@
+package gatussdk
+
+import (
+	"time"
+)
+
+// SuiteStatistics contains statistics computed from the execution results of a suite.
+// Since Gatus does not track the uptime of suites, these are computed client-side.
+type SuiteStatistics struct {
+	// Executions is the number of suite executions the statistics were computed from.
+	Executions int
+	// Successes is the number of successful suite executions.
+	Successes int
+	// SuccessRate is the percentage of successful suite executions, comparable to UptimeData.Uptime.
+	// It is zero if there are no executions.
+	SuccessRate float64
+	// AverageDuration is the average duration of the suite executions.
+	AverageDuration time.Duration
+	// LastFailure is the timestamp of the most recent failed execution, or the zero time if none failed.
+	LastFailure time.Time
+}
+
+// Statistics computes the success rate, average duration and last failure of the suite from its results.
+// Only the results retrieved from Gatus are taken into account.
+//
+// Example:
+//
+//	status, err := client.GetSuiteStatusByKey(context.Background(), "_check-authentication")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	stats := status.Statistics()
+//	fmt.Printf("Success rate: %.2f%% over %d executions\n", stats.SuccessRate, stats.Executions)
+func (s *SuiteStatus) Statistics() SuiteStatistics {
+	var stats SuiteStatistics
+	var total time.Duration
+	for _, result := range s.Results {
+		stats.Executions++
+		// Results built with only Duration, as before Elapsed existed, are still taken into account
+		if result.Elapsed != 0 {
+			total += result.Elapsed
+		} else {
+			total += time.Duration(result.Duration)
+		}
+		if result.Success {
+			stats.Successes++
+		} else if result.Timestamp.After(stats.LastFailure) {
+			stats.LastFailure = result.Timestamp
+		}
+	}
+	if stats.Executions > 0 {
+		stats.SuccessRate = float64(stats.Successes) / float64(stats.Executions) * 100
+		stats.AverageDuration = total / time.Duration(stats.Executions)
+	}
+	return stats
+}
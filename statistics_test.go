@@ -0,0 +1,57 @@
+package gatussdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSuiteStatus_Statistics(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	status := SuiteStatus{
+		Results: []SuiteResult{
+			{Success: false, Timestamp: now.Add(-4 * time.Minute), Duration: int64(2 * time.Second)},
+			{Success: true, Timestamp: now.Add(-3 * time.Minute), Duration: int64(time.Second)},
+			{Success: false, Timestamp: now.Add(-2 * time.Minute), Duration: int64(3 * time.Second)},
+			{Success: true, Timestamp: now.Add(-time.Minute), Duration: int64(2 * time.Second)},
+		},
+	}
+	stats := status.Statistics()
+	if stats.Executions != 4 || stats.Successes != 2 {
+		t.Errorf("Executions = %d, Successes = %d, want 4 and 2", stats.Executions, stats.Successes)
+	}
+	if stats.SuccessRate != 50 {
+		t.Errorf("SuccessRate = %v, want 50", stats.SuccessRate)
+	}
+	if stats.AverageDuration != 2*time.Second {
+		t.Errorf("AverageDuration = %v, want 2s", stats.AverageDuration)
+	}
+	if !stats.LastFailure.Equal(now.Add(-2 * time.Minute)) {
+		t.Errorf("LastFailure = %v, want %v", stats.LastFailure, now.Add(-2*time.Minute))
+	}
+}
+
+func TestSuiteStatus_Statistics_Durations(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []SuiteResult
+	}{
+		{name: "duration", results: []SuiteResult{{Duration: int64(time.Second)}, {Duration: int64(3 * time.Second)}}},
+		{name: "elapsed", results: []SuiteResult{{Elapsed: time.Second}, {Elapsed: 3 * time.Second}}},
+		{name: "mixed", results: []SuiteResult{{Duration: int64(time.Second)}, {Elapsed: 3 * time.Second, Duration: int64(3 * time.Second)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := SuiteStatus{Results: tt.results}
+			if stats := status.Statistics(); stats.AverageDuration != 2*time.Second {
+				t.Errorf("AverageDuration = %v, want 2s", stats.AverageDuration)
+			}
+		})
+	}
+}
+
+func TestSuiteStatus_Statistics_NoResults(t *testing.T) {
+	stats := (&SuiteStatus{}).Statistics()
+	if stats != (SuiteStatistics{}) {
+		t.Errorf("Statistics() = %+v, want zero value", stats)
+	}
+}
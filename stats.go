@@ -0,0 +1,87 @@
+package gatussdk
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// Stats contains counters describing the requests made by a client since it was created.
+type Stats struct {
+	// Requests is the number of requests made, not counting retries.
+	Requests uint64
+	// Errors counts failed requests by class.
+	Errors ErrorStats
+	// BytesReceived is the number of response body bytes read, before decompression.
+	BytesReceived uint64
+	// CacheHits is the number of requests answered without Gatus sending a new response body.
+	CacheHits uint64
+	// Retries is the number of retries performed.
+	Retries uint64
+}
+
+// ErrorStats counts failed requests by class.
+type ErrorStats struct {
+	// Network is the number of requests for which no response was received, including timeouts and cancellations.
+	Network uint64
+	// Client is the number of 4xx responses.
+	Client uint64
+	// Server is the number of 5xx responses.
+	Server uint64
+	// Decode is the number of responses that could not be decoded.
+	Decode uint64
+}
+
+// clientStats holds the counters behind Stats.
+type clientStats struct {
+	requests, bytesReceived, cacheHits, retries           atomic.Uint64
+	networkErrors, clientErrors, serverErrors, decodeErrs atomic.Uint64
+}
+
+// Stats returns a snapshot of the client's counters, e.g. to export the health of the SDK from long-running services.
+// Counters are updated atomically, but are not read as a single atomic snapshot.
+//
+// Example:
+//
+//	stats := client.Stats()
+//	fmt.Printf("%d requests, %d server errors, %d retries\n", stats.Requests, stats.Errors.Server, stats.Retries)
+func (c *Client) Stats() Stats {
+	return Stats{
+		Requests: c.stats.requests.Load(),
+		Errors: ErrorStats{
+			Network: c.stats.networkErrors.Load(),
+			Client:  c.stats.clientErrors.Load(),
+			Server:  c.stats.serverErrors.Load(),
+			Decode:  c.stats.decodeErrs.Load(),
+		},
+		BytesReceived: c.stats.bytesReceived.Load(),
+		CacheHits:     c.stats.cacheHits.Load(),
+		Retries:       c.stats.retries.Load(),
+	}
+}
+
+// recordResponse updates the counters for a completed request.
+func (s *clientStats) recordResponse(statusCode, retries int, err error) {
+	s.requests.Add(1)
+	s.retries.Add(uint64(retries))
+	switch {
+	case err != nil:
+		s.networkErrors.Add(1)
+	case statusCode >= 500:
+		s.serverErrors.Add(1)
+	case statusCode >= 400:
+		s.clientErrors.Add(1)
+	}
+}
+
+// countingBody is a response body counting the bytes read from it.
+type countingBody struct {
+	io.ReadCloser
+	counter *atomic.Uint64
+}
+
+// Read reads from the underlying body and adds the number of bytes read to the counter.
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.counter.Add(uint64(n))
+	return n, err
+}
@@ -0,0 +1,46 @@
+package gatussdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_Stats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/endpoints/statuses":
+			w.Write([]byte("[]"))
+		case "/api/v1/endpoints/core_invalid/statuses":
+			w.Write([]byte("{invalid"))
+		case "/api/v1/endpoints/core_flaky/statuses":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithRetry(RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond}))
+	ctx := context.Background()
+	client.GetAllEndpointStatuses(ctx)
+	client.GetEndpointStatusByKey(ctx, "core_invalid")
+	client.GetEndpointStatusByKey(ctx, "core_flaky")
+	client.GetEndpointStatusByKey(ctx, "core_missing")
+	client.GetEndpointStatusByKey(ctx, "invalid") // Not sent, so not counted
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	client.GetAllEndpointStatuses(canceled)
+
+	expected := Stats{
+		Requests:      5,
+		Errors:        ErrorStats{Network: 1, Client: 1, Server: 1, Decode: 1},
+		BytesReceived: uint64(len("[]") + len("{invalid")),
+		Retries:       1,
+	}
+	if stats := client.Stats(); stats != expected {
+		t.Errorf("Stats() = %+v, want %+v", stats, expected)
+	}
+}
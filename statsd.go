@@ -0,0 +1,133 @@
+package gatussdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultStatsDPrefix is the default prefix of the names of the metrics sent by EmitStatsD.
+const DefaultStatsDPrefix = "gatus."
+
+// maxStatsDPacketSize is the maximum size of the packets written by WriteStatsD, small enough for a UDP datagram
+// not to be fragmented on a typical network.
+const maxStatsDPacketSize = 1432
+
+// StatsDOptions configures the metrics sent by EmitStatsD and WriteStatsD. Zero values use the defaults.
+type StatsDOptions struct {
+	// Prefix is prepended to the name of every metric. Defaults to DefaultStatsDPrefix.
+	Prefix string
+	// Tags are added to every metric, in addition to the endpoint, group and name tags (e.g. "env:production").
+	Tags []string
+	// Tagless sends metrics in the plain StatsD format, for servers that don't support DogStatsD tags: tags are
+	// omitted and the key of the endpoint is appended to the name of each metric instead.
+	Tagless bool
+}
+
+// EmitStatsD polls the status of every endpoint every interval and sends gauges describing it to the StatsD or
+// DogStatsD server listening on UDP at address, until ctx is done, for teams on Datadog or other StatsD-based
+// monitoring without Prometheus. See WriteStatsD for the metrics sent. Polling and sending errors are logged and
+// polling continues, but an error dialing address is returned. If interval is zero or negative,
+// DefaultPollInterval is used.
+//
+// Example:
+//
+//	go client.EmitStatsD(ctx, "localhost:8125", time.Minute, StatsDOptions{Tags: []string{"env:production"}})
+func (c *Client) EmitStatsD(ctx context.Context, address string, interval time.Duration, options StatsDOptions, opts ...RequestOption) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", address)
+	if err != nil {
+		return fmt.Errorf("dialing StatsD server: %w", err)
+	}
+	defer conn.Close()
+	schedule := c.newPollSchedule(interval)
+	for {
+		statuses, err := c.GetAllEndpointStatuses(ctx, opts...)
+		if err == nil {
+			if err := WriteStatsD(conn, statuses, options); err != nil {
+				c.logger.WarnContext(ctx, "failed to send StatsD metrics", "error", err)
+			}
+		} else if ctx.Err() == nil {
+			c.logger.WarnContext(ctx, "failed to poll endpoint statuses", "error", err)
+		}
+		if !c.wait(ctx, schedule.jitter(schedule.interval)) {
+			return ctx.Err()
+		}
+	}
+}
+
+// WriteStatsD writes gauges describing statuses to w in the DogStatsD format, or the plain StatsD format if
+// options.Tagless is set, one packet per Write. For each endpoint with results, it writes:
+//
+//	gatus.endpoint.success            1 if the most recent result succeeded, 0 otherwise
+//	gatus.endpoint.response_time_ms   response time of the most recent result, in milliseconds
+//	gatus.endpoint.success_ratio      ratio of successful results among those returned by Gatus, between 0 and 1
+//
+// Each gauge is tagged with the endpoint key, group and name, e.g.:
+//
+//	gatus.endpoint.success:1|g|#endpoint:core_api,group:core,name:api
+func WriteStatsD(w io.Writer, statuses []EndpointStatus, options StatsDOptions) error {
+	if options.Prefix == "" {
+		options.Prefix = DefaultStatsDPrefix
+	}
+	var packet []byte
+	for i := range statuses {
+		last := statuses[i].LastResult()
+		if last == nil {
+			continue
+		}
+		successes := 0
+		for _, result := range statuses[i].Results {
+			if result.Success {
+				successes++
+			}
+		}
+		success := "0"
+		if last.Success {
+			success = "1"
+		}
+		gauges := [][2]string{
+			{"endpoint.success", success},
+			{"endpoint.response_time_ms", strconv.FormatInt(last.Elapsed.Milliseconds(), 10)},
+			{"endpoint.success_ratio", strconv.FormatFloat(float64(successes)/float64(len(statuses[i].Results)), 'g', 4, 64)},
+		}
+		for _, gauge := range gauges {
+			line := statsDLine(&statuses[i], options, gauge[0], gauge[1])
+			if len(packet) > 0 && len(packet)+1+len(line) > maxStatsDPacketSize {
+				if _, err := w.Write(packet); err != nil {
+					return fmt.Errorf("writing StatsD metrics: %w", err)
+				}
+				packet = packet[:0]
+			}
+			if len(packet) > 0 {
+				packet = append(packet, '\n')
+			}
+			packet = append(packet, line...)
+		}
+	}
+	if len(packet) > 0 {
+		if _, err := w.Write(packet); err != nil {
+			return fmt.Errorf("writing StatsD metrics: %w", err)
+		}
+	}
+	return nil
+}
+
+// statsDTagEscaper replaces the characters that delimit the tags of a DogStatsD metric.
+var statsDTagEscaper = strings.NewReplacer(",", "_", "|", "_", "\n", "_", "#", "_")
+
+// statsDLine returns the line of a gauge of the endpoint of status.
+func statsDLine(status *EndpointStatus, options StatsDOptions, name, value string) string {
+	if options.Tagless {
+		return options.Prefix + name + "." + status.Key + ":" + value + "|g"
+	}
+	tags := append([]string{"endpoint:" + status.Key, "group:" + status.Group, "name:" + status.Name}, options.Tags...)
+	for i := range tags {
+		tags[i] = statsDTagEscaper.Replace(tags[i])
+	}
+	return options.Prefix + name + ":" + value + "|g|#" + strings.Join(tags, ",")
+}
@@ -0,0 +1,143 @@
+package gatussdk
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// packetRecorder is an io.Writer recording each write as a packet.
+type packetRecorder struct {
+	packets []string
+}
+
+func (r *packetRecorder) Write(p []byte) (int, error) {
+	r.packets = append(r.packets, string(p))
+	return len(p), nil
+}
+
+func TestWriteStatsD(t *testing.T) {
+	statuses := []EndpointStatus{
+		{Key: "core_api", Group: "core", Name: "api", Results: []EndpointResult{
+			{Success: false, Elapsed: time.Second},
+			{Success: true, Elapsed: 150 * time.Millisecond},
+			{Success: true, Elapsed: 120 * time.Millisecond},
+			{Success: true, Elapsed: 100 * time.Millisecond},
+		}},
+		{Key: "core_new", Group: "core", Name: "new"},
+		{Key: "_odd", Name: "a,b|c", Results: []EndpointResult{{Success: false, Elapsed: 2 * time.Second}}},
+	}
+	tests := []struct {
+		name     string
+		options  StatsDOptions
+		expected []string
+	}{
+		{
+			name:    "dogstatsd",
+			options: StatsDOptions{Tags: []string{"env:production"}},
+			expected: []string{
+				"gatus.endpoint.success:1|g|#endpoint:core_api,group:core,name:api,env:production\n" +
+					"gatus.endpoint.response_time_ms:100|g|#endpoint:core_api,group:core,name:api,env:production\n" +
+					"gatus.endpoint.success_ratio:0.75|g|#endpoint:core_api,group:core,name:api,env:production\n" +
+					"gatus.endpoint.success:0|g|#endpoint:_odd,group:,name:a_b_c,env:production\n" +
+					"gatus.endpoint.response_time_ms:2000|g|#endpoint:_odd,group:,name:a_b_c,env:production\n" +
+					"gatus.endpoint.success_ratio:0|g|#endpoint:_odd,group:,name:a_b_c,env:production",
+			},
+		},
+		{
+			name:    "tagless",
+			options: StatsDOptions{Prefix: "status.", Tagless: true, Tags: []string{"ignored"}},
+			expected: []string{
+				"status.endpoint.success.core_api:1|g\n" +
+					"status.endpoint.response_time_ms.core_api:100|g\n" +
+					"status.endpoint.success_ratio.core_api:0.75|g\n" +
+					"status.endpoint.success._odd:0|g\n" +
+					"status.endpoint.response_time_ms._odd:2000|g\n" +
+					"status.endpoint.success_ratio._odd:0|g",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &packetRecorder{}
+			if err := WriteStatsD(recorder, statuses, tt.options); err != nil {
+				t.Fatalf("WriteStatsD() error = %v", err)
+			}
+			if !reflect.DeepEqual(recorder.packets, tt.expected) {
+				t.Errorf("got %q, want %q", recorder.packets, tt.expected)
+			}
+		})
+	}
+
+	t.Run("packets", func(t *testing.T) {
+		many := make([]EndpointStatus, 50)
+		for i := range many {
+			many[i] = EndpointStatus{Key: fmt.Sprintf("core_endpoint-%d", i), Results: []EndpointResult{{Success: true}}}
+		}
+		recorder := &packetRecorder{}
+		if err := WriteStatsD(recorder, many, StatsDOptions{}); err != nil {
+			t.Fatalf("WriteStatsD() error = %v", err)
+		}
+		if len(recorder.packets) < 2 {
+			t.Fatalf("expected metrics to be split into several packets, got %d", len(recorder.packets))
+		}
+		lines := 0
+		for _, packet := range recorder.packets {
+			if len(packet) > maxStatsDPacketSize {
+				t.Errorf("packet of %d bytes exceeds %d bytes", len(packet), maxStatsDPacketSize)
+			}
+			lines += strings.Count(packet, "\n") + 1
+		}
+		if lines != 3*len(many) {
+			t.Errorf("expected %d metrics, got %d", 3*len(many), lines)
+		}
+	})
+}
+
+func TestClient_EmitStatsD(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"key": "core_api", "group": "core", "name": "api", "results": [{"success": true}]}]`))
+	}))
+	defer server.Close()
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket() error = %v", err)
+	}
+	defer listener.Close()
+
+	clock := &recordingClock{}
+	client := NewClient(server.URL, WithClock(clock))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- client.EmitStatsD(ctx, listener.LocalAddr().String(), time.Minute, StatsDOptions{})
+	}()
+	listener.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buffer := make([]byte, maxStatsDPacketSize)
+	n, _, err := listener.ReadFrom(buffer)
+	if err != nil {
+		t.Fatalf("ReadFrom() error = %v", err)
+	}
+	if expected := "gatus.endpoint.success:1|g|#endpoint:core_api,group:core,name:api\n"; !strings.HasPrefix(string(buffer[:n]), expected) {
+		t.Errorf("expected packet starting with %q, got %q", expected, buffer[:n])
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	if len(clock.timers) == 0 || clock.timers[0] != time.Minute {
+		t.Errorf("expected polls a minute apart, got %v", clock.timers)
+	}
+
+	if err := client.EmitStatsD(context.Background(), "invalid address", time.Minute, StatsDOptions{}); err == nil {
+		t.Error("expected error dialing an invalid address")
+	}
+}
@@ -8,6 +8,7 @@ import (
 )
 
 // GetAllSuiteStatuses retrieves the status of all configured suites.
+// Use WithPage to retrieve results beyond the most recent ones.
 //
 // Example:
 //
@@ -18,8 +19,8 @@ import (
 //	for _, status := range statuses {
 //	    fmt.Printf("Suite: %s (Key: %s)\n", status.Name, status.Key)
 //	}
-func (c *Client) GetAllSuiteStatuses(ctx context.Context) ([]SuiteStatus, error) {
-	resp, err := c.doRequest(ctx, http.MethodGet, "/api/v1/suites/statuses")
+func (c *Client) GetAllSuiteStatuses(ctx context.Context, opts ...RequestOption) ([]SuiteStatus, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/api/v1/suites/statuses", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +32,8 @@ func (c *Client) GetAllSuiteStatuses(ctx context.Context) ([]SuiteStatus, error)
 }
 
 // GetSuiteStatusByKey retrieves the status of a specific suite by its key.
-// The key should be in the format: {group}_{name}.
+// The key should be in the format: {group}_{name} (use GenerateSuiteKey to create it).
+// Use WithPage to retrieve results beyond the most recent ones.
 //
 // Example:
 //
@@ -40,15 +42,12 @@ func (c *Client) GetAllSuiteStatuses(ctx context.Context) ([]SuiteStatus, error)
 //	    log.Fatal(err)
 //	}
 //	fmt.Printf("Suite %s has %d results\n", status.Name, len(status.Results))
-func (c *Client) GetSuiteStatusByKey(ctx context.Context, key string) (*SuiteStatus, error) {
-	if key == "" {
-		return nil, &ValidationError{
-			Field:   "key",
-			Message: "cannot be empty",
-		}
+func (c *Client) GetSuiteStatusByKey(ctx context.Context, key string, opts ...RequestOption) (*SuiteStatus, error) {
+	if err := ValidateSuiteKey(key); err != nil {
+		return nil, err
 	}
 	path := fmt.Sprintf("/api/v1/suites/%s/statuses", url.PathEscape(key))
-	resp, err := c.doRequest(ctx, http.MethodGet, path)
+	resp, err := c.doRequest(ctx, http.MethodGet, path, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +59,7 @@ func (c *Client) GetSuiteStatusByKey(ctx context.Context, key string) (*SuiteSta
 }
 
 // GetSuiteStatus retrieves the status of a specific suite by its group and name.
-// The key is generated internally using GenerateKey.
+// The key is generated internally using GenerateSuiteKey.
 //
 // Example:
 //
@@ -72,13 +71,27 @@ func (c *Client) GetSuiteStatusByKey(ctx context.Context, key string) (*SuiteSta
 //	    fmt.Printf("Suite execution at %s: success=%v, duration=%dms\n",
 //	        result.Timestamp, result.Success, result.Duration/1000000)
 //	}
-func (c *Client) GetSuiteStatus(ctx context.Context, group, name string) (*SuiteStatus, error) {
+func (c *Client) GetSuiteStatus(ctx context.Context, group, name string, opts ...RequestOption) (*SuiteStatus, error) {
 	if name == "" {
 		return nil, &ValidationError{
 			Field:   "name",
 			Message: "cannot be empty",
 		}
 	}
-	key := GenerateKey(group, name)
-	return c.GetSuiteStatusByKey(ctx, key)
+	key := GenerateSuiteKey(group, name)
+	return c.GetSuiteStatusByKey(ctx, key, opts...)
+}
+
+// GetSuiteHealthBadgeURL returns the URL for a suite's health badge.
+// This method does not make an HTTP request, it just constructs the URL, using the same encoding rules as
+// GetEndpointHealthBadgeURL. Suites only have a health badge, as Gatus does not track their uptime
+// or response time. The badge is only served by Gatus versions exposing suite badges.
+// The badge can be customized with BadgeOptions.
+//
+// Example:
+//
+//	url := client.GetSuiteHealthBadgeURL("_check-authentication")
+//	// Use the URL in markdown: ![Health](url)
+func (c *Client) GetSuiteHealthBadgeURL(key string, options ...BadgeOptions) string {
+	return fmt.Sprintf("%s/api/v1/suites/%s/health/badge.svg", c.baseURL, url.PathEscape(key)) + badgeQuery(options)
 }
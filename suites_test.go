@@ -368,3 +368,30 @@ func TestGetSuiteStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSuiteHealthBadgeURL(t *testing.T) {
+	client := NewClient("https://status.example.com/")
+	tests := []struct {
+		name     string
+		key      string
+		expected string
+	}{
+		{
+			name:     "suite without group",
+			key:      "_check-authentication",
+			expected: "https://status.example.com/api/v1/suites/_check-authentication/health/badge.svg",
+		},
+		{
+			name:     "key needing URL encoding",
+			key:      "test key",
+			expected: "https://status.example.com/api/v1/suites/test%20key/health/badge.svg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if url := client.GetSuiteHealthBadgeURL(tt.key); url != tt.expected {
+				t.Errorf("GetSuiteHealthBadgeURL() = %v, want %v", url, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package gatussdk
+
+// SystemStatus is the overall status of a set of endpoints, as typically displayed at the top of a status page.
+type SystemStatus int
+
+const (
+	// SystemStatusUnknown means no endpoint has a result to assess.
+	SystemStatusUnknown SystemStatus = iota
+	// SystemStatusOperational means every endpoint with a result is healthy.
+	SystemStatusOperational
+	// SystemStatusDegraded means at least one endpoint is degraded, and none is down.
+	SystemStatusDegraded
+	// SystemStatusMajorOutage means at least one endpoint is down.
+	SystemStatusMajorOutage
+)
+
+// String returns the name of the system status.
+func (s SystemStatus) String() string {
+	switch s {
+	case SystemStatusOperational:
+		return "operational"
+	case SystemStatusDegraded:
+		return "degraded"
+	case SystemStatusMajorOutage:
+		return "major outage"
+	}
+	return "unknown"
+}
+
+// MarshalText encodes the system status as its name.
+func (s SystemStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a system status from its name. Unrecognized names decode to SystemStatusUnknown.
+func (s *SystemStatus) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "operational":
+		*s = SystemStatusOperational
+	case "degraded":
+		*s = SystemStatusDegraded
+	case "major outage":
+		*s = SystemStatusMajorOutage
+	default:
+		*s = SystemStatusUnknown
+	}
+	return nil
+}
+
+// Summary summarizes the health of a set of endpoints.
+type Summary struct {
+	// Total is the number of endpoints.
+	Total int `json:"total"`
+	// Up is the number of healthy endpoints.
+	Up int `json:"up"`
+	// Degraded is the number of degraded endpoints.
+	Degraded int `json:"degraded"`
+	// Down is the number of endpoints that are down.
+	Down int `json:"down"`
+	// Unknown is the number of endpoints without results.
+	Unknown int `json:"unknown"`
+	// Status is the overall status, determined by the worst severity among the endpoints.
+	Status SystemStatus `json:"status"`
+	// Failing contains the keys of the endpoints that are down, in the order they were given.
+	Failing []string `json:"failing,omitempty"`
+}
+
+// Summarize counts endpoints by severity, as computed from their most recent result, and rolls them up into
+// an overall status.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	summary := Summarize(statuses)
+//	fmt.Printf("%s: %d/%d up\n", summary.Status, summary.Up, summary.Total)
+//	for _, key := range summary.Failing {
+//	    fmt.Printf("%s is down\n", key)
+//	}
+func Summarize(statuses []EndpointStatus) Summary {
+	summary := Summary{Total: len(statuses)}
+	for i := range statuses {
+		switch statuses[i].Severity() {
+		case SeverityHealthy:
+			summary.Up++
+		case SeverityDegraded:
+			summary.Degraded++
+		case SeverityDown:
+			summary.Down++
+			summary.Failing = append(summary.Failing, statuses[i].Key)
+		default:
+			summary.Unknown++
+		}
+	}
+	switch {
+	case summary.Down > 0:
+		summary.Status = SystemStatusMajorOutage
+	case summary.Degraded > 0:
+		summary.Status = SystemStatusDegraded
+	case summary.Up > 0:
+		summary.Status = SystemStatusOperational
+	}
+	return summary
+}
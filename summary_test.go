@@ -0,0 +1,59 @@
+package gatussdk
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	healthy := EndpointStatus{Key: "core_healthy", Results: []EndpointResult{{Success: true}}}
+	degraded := EndpointStatus{Key: "core_degraded", Results: []EndpointResult{{Success: true, Errors: []string{"slow"}}}}
+	down := EndpointStatus{Key: "core_down", Results: []EndpointResult{{Success: true}, {Success: false}}}
+	unknown := EndpointStatus{Key: "core_unknown"}
+	tests := []struct {
+		name     string
+		statuses []EndpointStatus
+		expected Summary
+	}{
+		{
+			name:     "empty",
+			expected: Summary{Status: SystemStatusUnknown},
+		},
+		{
+			name:     "operational",
+			statuses: []EndpointStatus{healthy, unknown},
+			expected: Summary{Total: 2, Up: 1, Unknown: 1, Status: SystemStatusOperational},
+		},
+		{
+			name:     "degraded",
+			statuses: []EndpointStatus{healthy, degraded},
+			expected: Summary{Total: 2, Up: 1, Degraded: 1, Status: SystemStatusDegraded},
+		},
+		{
+			name:     "major outage",
+			statuses: []EndpointStatus{down, healthy, degraded, unknown},
+			expected: Summary{Total: 4, Up: 1, Degraded: 1, Down: 1, Unknown: 1, Status: SystemStatusMajorOutage, Failing: []string{"core_down"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if summary := Summarize(tt.statuses); !reflect.DeepEqual(summary, tt.expected) {
+				t.Errorf("Summarize() = %+v, want %+v", summary, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSystemStatus_JSON(t *testing.T) {
+	for _, status := range []SystemStatus{SystemStatusUnknown, SystemStatusOperational, SystemStatusDegraded, SystemStatusMajorOutage} {
+		data, err := json.Marshal(status)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		var decoded SystemStatus
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded != status {
+			t.Errorf("round trip of %s = %v, %v", status, decoded, err)
+		}
+	}
+}
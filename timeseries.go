@@ -0,0 +1,128 @@
+package gatussdk
+
+import (
+	"slices"
+	"time"
+)
+
+// Sample is a point of a TimeSeries, aggregating one or more results.
+type Sample struct {
+	// Timestamp is the timestamp of the result, or the start of the interval for resampled series.
+	Timestamp time.Time `json:"timestamp"`
+	// Count is the number of results aggregated into the sample. Resampled series have samples without results
+	// for intervals without results, so that gaps show on charts.
+	Count int `json:"count"`
+	// Successes is the number of successful results aggregated into the sample.
+	Successes int `json:"successes"`
+	// ResponseTime is the average response time of the results aggregated into the sample.
+	ResponseTime time.Duration `json:"responseTime"`
+}
+
+// SuccessRatio returns the ratio of successful results in the sample, between 0 and 1,
+// or 0 if the sample has no results.
+func (s Sample) SuccessRatio() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Successes) / float64(s.Count)
+}
+
+// TimeSeries is a chronologically ordered series of samples built from results, forming the basis of charts
+// and analytics.
+type TimeSeries struct {
+	// Samples are the samples of the series, oldest first.
+	Samples []Sample `json:"samples"`
+}
+
+// NewTimeSeries creates a time series with one sample per result. results don't need to be sorted,
+// and are not modified.
+//
+// Example:
+//
+//	series := NewTimeSeries(status.Results)
+//	for _, sample := range series.Resample(time.Hour).Samples {
+//	    fmt.Printf("%s: %.1f%% (%s)\n", sample.Timestamp.Format(time.Kitchen), sample.SuccessRatio()*100, sample.ResponseTime)
+//	}
+func NewTimeSeries(results []EndpointResult) *TimeSeries {
+	samples := make([]Sample, len(results))
+	for i, result := range results {
+		samples[i] = Sample{Timestamp: result.Timestamp, Count: 1, ResponseTime: result.Elapsed}
+		if result.Success {
+			samples[i].Successes = 1
+		}
+	}
+	slices.SortStableFunc(samples, func(a, b Sample) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return &TimeSeries{Samples: samples}
+}
+
+// At returns the sample in effect at t, which is the most recent sample at or before t.
+// It returns false if t is before the first sample.
+func (ts *TimeSeries) At(t time.Time) (Sample, bool) {
+	i, found := slices.BinarySearchFunc(ts.Samples, t, func(sample Sample, t time.Time) int {
+		return sample.Timestamp.Compare(t)
+	})
+	if found {
+		// Several samples may share the timestamp, in which case the last one is in effect
+		for i+1 < len(ts.Samples) && ts.Samples[i+1].Timestamp.Equal(t) {
+			i++
+		}
+		return ts.Samples[i], true
+	}
+	if i == 0 {
+		return Sample{}, false
+	}
+	return ts.Samples[i-1], true
+}
+
+// Resample returns a new time series with one sample per interval, from the interval of the first sample to the
+// interval of the last one. Intervals are aligned on multiples of interval since the zero time, like time.Truncate,
+// and intervals without samples have a sample without results. The series is returned unchanged if interval is
+// not positive.
+func (ts *TimeSeries) Resample(interval time.Duration) *TimeSeries {
+	if interval <= 0 || len(ts.Samples) == 0 {
+		return &TimeSeries{Samples: slices.Clone(ts.Samples)}
+	}
+	first := ts.Samples[0].Timestamp.Truncate(interval)
+	last := ts.Samples[len(ts.Samples)-1].Timestamp.Truncate(interval)
+	samples := make([]Sample, last.Sub(first)/interval+1)
+	totals := make([]time.Duration, len(samples))
+	for i := range samples {
+		samples[i].Timestamp = first.Add(time.Duration(i) * interval)
+	}
+	for _, sample := range ts.Samples {
+		i := sample.Timestamp.Truncate(interval).Sub(first) / interval
+		samples[i].Count += sample.Count
+		samples[i].Successes += sample.Successes
+		totals[i] += sample.ResponseTime * time.Duration(sample.Count)
+	}
+	for i := range samples {
+		if samples[i].Count > 0 {
+			samples[i].ResponseTime = totals[i] / time.Duration(samples[i].Count)
+		}
+	}
+	return &TimeSeries{Samples: samples}
+}
+
+// SuccessRatio returns the ratio of successful results, between 0 and 1, over the window ending at the most
+// recent sample: samples strictly after the most recent timestamp minus window are counted. A window that is not
+// positive covers the whole series. It returns false if the window has no results.
+func (ts *TimeSeries) SuccessRatio(window time.Duration) (float64, bool) {
+	if len(ts.Samples) == 0 {
+		return 0, false
+	}
+	start := ts.Samples[len(ts.Samples)-1].Timestamp.Add(-window)
+	var total Sample
+	for _, sample := range ts.Samples {
+		if window > 0 && !sample.Timestamp.After(start) {
+			continue
+		}
+		total.Count += sample.Count
+		total.Successes += sample.Successes
+	}
+	if total.Count == 0 {
+		return 0, false
+	}
+	return total.SuccessRatio(), true
+}
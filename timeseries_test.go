@@ -0,0 +1,98 @@
+package gatussdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeSeries(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ms := time.Millisecond
+	results := newTimedResults(start, 100*ms, 200*ms, 300*ms, 400*ms, 500*ms, 600*ms)
+	results[1].Success = false
+	results[4].Success = false
+	// Results don't need to be sorted
+	results[0], results[5] = results[5], results[0]
+	series := NewTimeSeries(results)
+
+	t.Run("NewTimeSeries", func(t *testing.T) {
+		if len(series.Samples) != 6 {
+			t.Fatalf("got %d samples, want 6", len(series.Samples))
+		}
+		for i, sample := range series.Samples {
+			if !sample.Timestamp.Equal(start.Add(time.Duration(i)*time.Minute)) || sample.Count != 1 {
+				t.Errorf("unexpected sample %d: %+v", i, sample)
+			}
+		}
+	})
+
+	t.Run("At", func(t *testing.T) {
+		tests := []struct {
+			t        time.Time
+			expected time.Duration
+			found    bool
+		}{
+			{t: start.Add(-time.Second), found: false},
+			{t: start, expected: 100 * ms, found: true},
+			{t: start.Add(90 * time.Second), expected: 200 * ms, found: true},
+			{t: start.Add(time.Hour), expected: 600 * ms, found: true},
+		}
+		for _, tt := range tests {
+			sample, found := series.At(tt.t)
+			if found != tt.found || sample.ResponseTime != tt.expected {
+				t.Errorf("At(%v) = %+v, %v, want response time %v, %v", tt.t, sample, found, tt.expected, tt.found)
+			}
+		}
+	})
+
+	t.Run("Resample", func(t *testing.T) {
+		resampled := series.Resample(2 * time.Minute)
+		expected := []Sample{
+			{Timestamp: start, Count: 2, Successes: 1, ResponseTime: 150 * ms},
+			{Timestamp: start.Add(2 * time.Minute), Count: 2, Successes: 2, ResponseTime: 350 * ms},
+			{Timestamp: start.Add(4 * time.Minute), Count: 2, Successes: 1, ResponseTime: 550 * ms},
+		}
+		if len(resampled.Samples) != len(expected) {
+			t.Fatalf("got %d samples, want %d", len(resampled.Samples), len(expected))
+		}
+		for i := range expected {
+			if resampled.Samples[i] != expected[i] {
+				t.Errorf("sample %d = %+v, want %+v", i, resampled.Samples[i], expected[i])
+			}
+		}
+		// Resampling aggregated samples weighs their response times by their number of results
+		hourly := resampled.Resample(time.Hour)
+		if len(hourly.Samples) != 1 || hourly.Samples[0].Count != 6 || hourly.Samples[0].ResponseTime != 350*ms {
+			t.Errorf("unexpected hourly samples: %+v", hourly.Samples)
+		}
+	})
+
+	t.Run("Resample with gaps", func(t *testing.T) {
+		gappy := NewTimeSeries([]EndpointResult{
+			{Timestamp: start, Success: true},
+			{Timestamp: start.Add(3 * time.Minute), Success: true},
+		}).Resample(time.Minute)
+		if len(gappy.Samples) != 4 || gappy.Samples[1].Count != 0 || gappy.Samples[2].SuccessRatio() != 0 {
+			t.Errorf("expected empty samples for intervals without results, got %+v", gappy.Samples)
+		}
+	})
+
+	t.Run("SuccessRatio", func(t *testing.T) {
+		tests := []struct {
+			window   time.Duration
+			expected float64
+		}{
+			{window: 0, expected: 4.0 / 6},
+			{window: 2 * time.Minute, expected: 0.5},
+			{window: time.Minute, expected: 1},
+		}
+		for _, tt := range tests {
+			if actual, ok := series.SuccessRatio(tt.window); !ok || actual != tt.expected {
+				t.Errorf("SuccessRatio(%v) = %v, %v, want %v", tt.window, actual, ok, tt.expected)
+			}
+		}
+		if _, ok := NewTimeSeries(nil).SuccessRatio(time.Hour); ok {
+			t.Error("expected no success ratio for an empty series")
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package gatussdk
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// TokenProvider returns a new bearer token, e.g. by exchanging client credentials with an OIDC provider.
+type TokenProvider func(ctx context.Context) (string, error)
+
+// WithTokenProvider sets a provider of the bearer token sent in the Authorization header of every request,
+// for instances fronted by an identity-aware proxy issuing short-lived tokens. It takes precedence over
+// WithBearerToken, and methods taking their own token, such as PushExternalEndpointResult, send that token instead.
+//
+// The token is fetched on the first request and cached. When a request fails with 401 Unauthorized, the cached
+// token is invalidated, a new one is fetched, and the request is retried once before the error is returned.
+// Requests whose body cannot be replayed are not retried, but the token is still refreshed for later requests.
+//
+// Example:
+//
+//	config := clientcredentials.Config{ClientID: id, ClientSecret: secret, TokenURL: tokenURL}
+//	client := NewClient("https://status.example.org", WithTokenProvider(func(ctx context.Context) (string, error) {
+//	    token, err := config.Token(ctx)
+//	    if err != nil {
+//	        return "", err
+//	    }
+//	    return token.AccessToken, nil
+//	}))
+func WithTokenProvider(provider TokenProvider) ClientOption {
+	return func(c *Client) {
+		if provider == nil {
+			c.tokens = nil
+			return
+		}
+		c.tokens = &tokenCache{provider: provider}
+	}
+}
+
+// tokenCache caches the token returned by a TokenProvider.
+type tokenCache struct {
+	provider TokenProvider
+
+	mu    sync.Mutex
+	token string
+}
+
+// get returns the cached token, fetching a new one if there is none.
+func (t *tokenCache) get(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != "" {
+		return t.token, nil
+	}
+	token, err := t.provider(ctx)
+	if err != nil {
+		return "", err
+	}
+	t.token = token
+	return token, nil
+}
+
+// invalidate discards the cached token if it is still the given token, so that concurrent requests failing
+// with the same token only cause a single refresh.
+func (t *tokenCache) invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token == token {
+		t.token = ""
+	}
+}
+
+// refreshToken invalidates the token of a request that failed with 401 Unauthorized and prepares the request to be
+// retried with a new token. It returns false if the request cannot be retried.
+func (c *Client) refreshToken(req *http.Request, resp *http.Response, token string) (bool, error) {
+	c.tokens.invalidate(token)
+	if req.Body != nil && req.GetBody == nil {
+		return false, nil
+	}
+	newToken, err := c.tokens.get(req.Context())
+	if err != nil {
+		_ = resp.Body.Close()
+		return false, err
+	}
+	if err := c.prepareRetry(req.Context(), req, resp, 0); err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+newToken)
+	return true, nil
+}
@@ -0,0 +1,94 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWithTokenProvider(t *testing.T) {
+	var validToken atomic.Value
+	validToken.Store("token-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	var fetches atomic.Int32
+	client := NewClient(server.URL, WithBearerToken("ignored"), WithTokenProvider(func(ctx context.Context) (string, error) {
+		return fmt.Sprintf("token-%d", fetches.Add(1)), nil
+	}))
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("expected the token to be cached, got %d fetches", fetches.Load())
+	}
+
+	// The token expires, so the next request is retried with a new token
+	validToken.Store("token-2")
+	if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if fetches.Load() != 2 {
+		t.Errorf("expected the token to be refreshed once, got %d fetches", fetches.Load())
+	}
+
+	// Requests are only retried once
+	validToken.Store("revoked")
+	_, err := client.GetAllEndpointStatuses(context.Background())
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+	if fetches.Load() != 3 {
+		t.Errorf("expected a single refresh, got %d fetches", fetches.Load())
+	}
+}
+
+func TestWithTokenProvider_Error(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithTokenProvider(func(ctx context.Context) (string, error) {
+		return "", errors.New("identity provider unavailable")
+	}))
+	_, err := client.GetAllEndpointStatuses(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "identity provider unavailable") {
+		t.Errorf("expected token error, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("got %d requests, want 0", requests)
+	}
+}
+
+func TestWithTokenProvider_ExplicitToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer push-token" {
+			t.Errorf("Authorization = %v, want Bearer push-token", r.Header.Get("Authorization"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithTokenProvider(func(ctx context.Context) (string, error) {
+		t.Error("expected the provider not to be called")
+		return "provided", nil
+	}))
+	if err := client.PushExternalEndpointResult(context.Background(), "core_worker", "push-token", true, "", ""); err != nil {
+		t.Errorf("PushExternalEndpointResult() error = %v", err)
+	}
+}
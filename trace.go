@@ -0,0 +1,120 @@
+package gatussdk
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// RequestTimings breaks down where the time of a request was spent, to tell apart slowness of the network
+// from slowness of Gatus itself. Phases that did not happen, such as DNS resolution and connection establishment
+// when an idle connection was reused, are zero.
+type RequestTimings struct {
+	// DNSLookup is the time spent resolving the host name.
+	DNSLookup time.Duration
+	// Connect is the time spent establishing the TCP connection.
+	Connect time.Duration
+	// TLSHandshake is the time spent performing the TLS handshake.
+	TLSHandshake time.Duration
+	// TimeToFirstByte is the time from requesting a connection to receiving the first byte of the response,
+	// which includes the phases above as well as the time Gatus took to process the request.
+	TimeToFirstByte time.Duration
+	// ConnectionReused is whether an idle connection was reused for the request.
+	ConnectionReused bool
+}
+
+// WithClientTrace sets an httptrace.ClientTrace whose hooks are called for every request made by the client,
+// in addition to those of any trace already attached to the request's context.
+//
+// For most use cases, MetricsEvent.Timings provides a simpler summary of the same information.
+//
+// Example:
+//
+//	client := NewClient("https://status.example.org", WithClientTrace(&httptrace.ClientTrace{
+//	    GotConn: func(info httptrace.GotConnInfo) {
+//	        log.Printf("connection reused: %v", info.Reused)
+//	    },
+//	}))
+func WithClientTrace(trace *httptrace.ClientTrace) ClientOption {
+	return func(c *Client) {
+		c.clientTrace = trace
+	}
+}
+
+// timingsRecorder records the RequestTimings of a request from httptrace hooks.
+// Hooks may be called from different goroutines, hence the mutex.
+type timingsRecorder struct {
+	mu                                   sync.Mutex
+	start, dnsStart, connStart, tlsStart time.Time
+	timings                              RequestTimings
+}
+
+// trace attaches the client trace, if any, and a timings recorder, if a metrics hook is set, to req.
+// The returned recorder is nil if timings are not recorded.
+func (c *Client) trace(req *http.Request) (*http.Request, *timingsRecorder) {
+	if c.clientTrace == nil && c.metricsHook == nil {
+		return req, nil
+	}
+	ctx := req.Context()
+	var recorder *timingsRecorder
+	if c.metricsHook != nil {
+		recorder = &timingsRecorder{}
+		ctx = httptrace.WithClientTrace(ctx, recorder.clientTrace())
+	}
+	if c.clientTrace != nil {
+		ctx = httptrace.WithClientTrace(ctx, c.clientTrace)
+	}
+	return req.WithContext(ctx), recorder
+}
+
+// clientTrace returns the hooks recording the timings. Since GetConn is called for every attempt,
+// the timings are those of the last attempt when a request is retried.
+func (r *timingsRecorder) clientTrace() *httptrace.ClientTrace {
+	record := func(f func(now time.Time)) {
+		now := time.Now()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		f(now)
+	}
+	return &httptrace.ClientTrace{
+		GetConn: func(string) {
+			record(func(now time.Time) { r.start, r.timings = now, RequestTimings{} })
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			record(func(time.Time) { r.timings.ConnectionReused = info.Reused })
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			record(func(now time.Time) { r.dnsStart = now })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			record(func(now time.Time) { r.timings.DNSLookup = now.Sub(r.dnsStart) })
+		},
+		ConnectStart: func(string, string) {
+			record(func(now time.Time) { r.connStart = now })
+		},
+		ConnectDone: func(string, string, error) {
+			record(func(now time.Time) { r.timings.Connect = now.Sub(r.connStart) })
+		},
+		TLSHandshakeStart: func() {
+			record(func(now time.Time) { r.tlsStart = now })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			record(func(now time.Time) { r.timings.TLSHandshake = now.Sub(r.tlsStart) })
+		},
+		GotFirstResponseByte: func() {
+			record(func(now time.Time) { r.timings.TimeToFirstByte = now.Sub(r.start) })
+		},
+	}
+}
+
+// result returns the recorded timings. It is safe to call on a nil recorder.
+func (r *timingsRecorder) result() RequestTimings {
+	if r == nil {
+		return RequestTimings{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.timings
+}
@@ -0,0 +1,54 @@
+package gatussdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"testing"
+)
+
+func TestMetricsEvent_Timings(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	var events []MetricsEvent
+	client := NewClient(server.URL, WithHTTPClient(server.Client()), WithMetricsHook(func(event MetricsEvent) {
+		events = append(events, event)
+	}))
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetAllEndpointStatuses(context.Background()); err != nil {
+			t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+		}
+	}
+	first, second := events[0].Timings, events[1].Timings
+	if first.ConnectionReused || first.Connect <= 0 || first.TLSHandshake <= 0 || first.TimeToFirstByte < first.TLSHandshake {
+		t.Errorf("unexpected timings for new connection: %+v", first)
+	}
+	if !second.ConnectionReused || second.Connect != 0 || second.TLSHandshake != 0 || second.TimeToFirstByte <= 0 {
+		t.Errorf("unexpected timings for reused connection: %+v", second)
+	}
+}
+
+func TestWithClientTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	var clientHook, contextHook bool
+	client := NewClient(server.URL, WithClientTrace(&httptrace.ClientTrace{
+		GotFirstResponseByte: func() { clientHook = true },
+	}))
+	ctx := httptrace.WithClientTrace(context.Background(), &httptrace.ClientTrace{
+		GotFirstResponseByte: func() { contextHook = true },
+	})
+	if _, err := client.GetAllEndpointStatuses(ctx); err != nil {
+		t.Fatalf("GetAllEndpointStatuses() error = %v", err)
+	}
+	if !clientHook || !contextHook {
+		t.Errorf("expected both traces to be called, got client=%v context=%v", clientHook, contextHook)
+	}
+}
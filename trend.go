@@ -0,0 +1,148 @@
+package gatussdk
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultTrendWindow is the default number of recent results a response time trend is fitted over.
+	DefaultTrendWindow = 20
+	// DefaultTrendMinRSquared is the default minimum coefficient of determination for a trend to be considered steady.
+	DefaultTrendMinRSquared = 0.5
+	// minTrendSamples is the minimum number of samples needed to fit a meaningful trend.
+	minTrendSamples = 3
+)
+
+// Trend is a linear trend fitted over the response times of an endpoint.
+type Trend struct {
+	// Samples is the number of results the trend was fitted over.
+	Samples int `json:"samples"`
+	// Slope is the change in response time per hour. It is positive when the response time increases.
+	Slope time.Duration `json:"slope"`
+	// RSquared is the coefficient of determination of the fit, between 0 and 1. The closer it is to 1,
+	// the more steadily the response time follows the trend.
+	RSquared float64 `json:"rSquared"`
+	// Start is the response time predicted by the trend for the oldest sample.
+	Start time.Duration `json:"start"`
+	// End is the response time predicted by the trend for the most recent sample.
+	End time.Duration `json:"end"`
+}
+
+// TrendOptions configures the detection of increasing response times. Zero values use the defaults.
+type TrendOptions struct {
+	// Window is the number of most recent successful results to fit the trend over. Defaults to DefaultTrendWindow.
+	Window int
+	// MinSlope is the minimum increase in response time per hour for a trend to be flagged.
+	// Defaults to any increase.
+	MinSlope time.Duration
+	// MinRSquared is the minimum coefficient of determination for a trend to be flagged, filtering out
+	// noisy response times that only increase on average. Defaults to DefaultTrendMinRSquared.
+	MinRSquared float64
+}
+
+func (o TrendOptions) withDefaults() TrendOptions {
+	if o.Window <= 0 {
+		o.Window = DefaultTrendWindow
+	}
+	if o.MinRSquared <= 0 {
+		o.MinRSquared = DefaultTrendMinRSquared
+	}
+	return o
+}
+
+// EndpointTrend is the response time trend of an endpoint.
+type EndpointTrend struct {
+	// Key is the key of the endpoint.
+	Key string `json:"key"`
+	// Name is the name of the endpoint.
+	Name string `json:"name"`
+	// Group is the group of the endpoint.
+	Group string `json:"group,omitempty"`
+	// Trend is the trend fitted over the recent response times of the endpoint.
+	Trend Trend `json:"trend"`
+}
+
+// ResponseTimeTrend fits a linear trend, using least squares, over the response times of the last window
+// successful results. Failed results are ignored, since their response time usually reflects a timeout rather
+// than the latency of the endpoint. It returns false if there are fewer than 3 samples or if they all share
+// the same timestamp.
+//
+// Example:
+//
+//	if trend, ok := ResponseTimeTrend(status.Results, 50); ok && trend.Slope > 0 {
+//	    fmt.Printf("response time increases by %s per hour (R²=%.2f)\n", trend.Slope, trend.RSquared)
+//	}
+func ResponseTimeTrend(results []EndpointResult, window int) (Trend, bool) {
+	var samples []EndpointResult
+	for i := len(results) - 1; i >= 0 && len(samples) < window; i-- {
+		if results[i].Success {
+			samples = append(samples, results[i])
+		}
+	}
+	if len(samples) < minTrendSamples {
+		return Trend{}, false
+	}
+	slices.Reverse(samples)
+	origin := samples[0].Timestamp
+	n := float64(len(samples))
+	var sumX, sumY float64
+	for _, sample := range samples {
+		sumX += sample.Timestamp.Sub(origin).Hours()
+		sumY += float64(sample.Elapsed)
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var sxx, sxy, syy float64
+	for _, sample := range samples {
+		dx := sample.Timestamp.Sub(origin).Hours() - meanX
+		dy := float64(sample.Elapsed) - meanY
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return Trend{}, false
+	}
+	slope := sxy / sxx
+	trend := Trend{
+		Samples: len(samples),
+		Slope:   time.Duration(slope),
+		// A constant response time is perfectly described by a flat trend
+		RSquared: 1,
+		Start:    time.Duration(meanY - slope*meanX),
+		End:      time.Duration(meanY + slope*(samples[len(samples)-1].Timestamp.Sub(origin).Hours()-meanX)),
+	}
+	if syy > 0 {
+		trend.RSquared = sxy * sxy / (sxx * syy)
+	}
+	return trend, true
+}
+
+// IncreasingResponseTimes returns the endpoints whose response time is steadily increasing, steepest first,
+// to catch slow degradations before they break conditions.
+//
+// Example:
+//
+//	statuses, err := client.GetAllEndpointStatuses(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, endpoint := range IncreasingResponseTimes(statuses, TrendOptions{MinSlope: 10 * time.Millisecond}) {
+//	    fmt.Printf("%s: +%s/h, from %s to %s\n", endpoint.Key, endpoint.Trend.Slope, endpoint.Trend.Start, endpoint.Trend.End)
+//	}
+func IncreasingResponseTimes(statuses []EndpointStatus, options TrendOptions) []EndpointTrend {
+	options = options.withDefaults()
+	var trends []EndpointTrend
+	for _, status := range statuses {
+		trend, ok := ResponseTimeTrend(status.Results, options.Window)
+		if !ok || trend.Slope <= 0 || trend.Slope < options.MinSlope || trend.RSquared < options.MinRSquared {
+			continue
+		}
+		trends = append(trends, EndpointTrend{Key: status.Key, Name: status.Name, Group: status.Group, Trend: trend})
+	}
+	slices.SortFunc(trends, func(a, b EndpointTrend) int {
+		return cmp.Or(cmp.Compare(b.Trend.Slope, a.Trend.Slope), cmp.Compare(a.Key, b.Key))
+	})
+	return trends
+}
@@ -0,0 +1,82 @@
+package gatussdk
+
+import (
+	"testing"
+	"time"
+)
+
+// newTimedResults creates successful results one minute apart with the given response times.
+func newTimedResults(start time.Time, elapsed ...time.Duration) []EndpointResult {
+	results := make([]EndpointResult, len(elapsed))
+	for i, e := range elapsed {
+		results[i] = EndpointResult{Success: true, Timestamp: start.Add(time.Duration(i) * time.Minute), Elapsed: e, Duration: int64(e)}
+	}
+	return results
+}
+
+func TestResponseTimeTrend(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ms := time.Millisecond
+	t.Run("increasing", func(t *testing.T) {
+		// +1ms per minute, i.e. +60ms per hour
+		trend, ok := ResponseTimeTrend(newTimedResults(start, 100*ms, 101*ms, 102*ms, 103*ms, 104*ms), 10)
+		if !ok {
+			t.Fatal("expected a trend")
+		}
+		if trend.Samples != 5 || trend.Slope.Round(time.Microsecond) != 60*ms || trend.RSquared < 0.999 {
+			t.Errorf("unexpected trend: %+v", trend)
+		}
+		if trend.Start.Round(time.Microsecond) != 100*ms || trend.End.Round(time.Microsecond) != 104*ms {
+			t.Errorf("Start, End = %v, %v, want 100ms, 104ms", trend.Start, trend.End)
+		}
+	})
+	t.Run("window", func(t *testing.T) {
+		// Only the last 3 samples, which are flat, are considered
+		trend, ok := ResponseTimeTrend(newTimedResults(start, 10*ms, 50*ms, 100*ms, 100*ms, 100*ms), 3)
+		if !ok || trend.Samples != 3 || trend.Slope != 0 || trend.RSquared != 1 {
+			t.Errorf("unexpected trend: %+v", trend)
+		}
+	})
+	t.Run("failed results are ignored", func(t *testing.T) {
+		results := newTimedResults(start, 100*ms, 10*time.Second, 100*ms, 100*ms)
+		results[1].Success = false
+		trend, ok := ResponseTimeTrend(results, 10)
+		if !ok || trend.Samples != 3 || trend.Slope != 0 {
+			t.Errorf("unexpected trend: %+v", trend)
+		}
+	})
+	t.Run("not enough samples", func(t *testing.T) {
+		if _, ok := ResponseTimeTrend(newTimedResults(start, 100*ms, 200*ms), 10); ok {
+			t.Error("expected no trend")
+		}
+	})
+	t.Run("same timestamp", func(t *testing.T) {
+		results := newTimedResults(start, 100*ms, 200*ms, 300*ms)
+		for i := range results {
+			results[i].Timestamp = start
+		}
+		if _, ok := ResponseTimeTrend(results, 10); ok {
+			t.Error("expected no trend")
+		}
+	})
+}
+
+func TestIncreasingResponseTimes(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ms := time.Millisecond
+	statuses := []EndpointStatus{
+		{Key: "core_slow", Results: newTimedResults(start, 100*ms, 110*ms, 120*ms, 130*ms)},
+		{Key: "core_flat", Results: newTimedResults(start, 100*ms, 100*ms, 100*ms, 100*ms)},
+		{Key: "core_noisy", Results: newTimedResults(start, 100*ms, 300*ms, 50*ms, 200*ms, 60*ms, 250*ms)},
+		{Key: "core_faster", Results: newTimedResults(start, 130*ms, 120*ms, 110*ms, 100*ms)},
+		{Key: "core_steep", Results: newTimedResults(start, 100*ms, 200*ms, 300*ms, 400*ms)},
+	}
+	trends := IncreasingResponseTimes(statuses, TrendOptions{})
+	if len(trends) != 2 || trends[0].Key != "core_steep" || trends[1].Key != "core_slow" {
+		t.Fatalf("unexpected trends: %+v", trends)
+	}
+	trends = IncreasingResponseTimes(statuses, TrendOptions{MinSlope: time.Second})
+	if len(trends) != 1 || trends[0].Key != "core_steep" {
+		t.Errorf("unexpected trends with minimum slope: %+v", trends)
+	}
+}
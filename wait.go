@@ -0,0 +1,151 @@
+package gatussdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultWaitInterval is the default interval between the polls of WaitForHealthy and WaitForSuiteSuccess.
+const DefaultWaitInterval = 10 * time.Second
+
+// WaitOptions configures how WaitForHealthy and WaitForSuiteSuccess wait. Zero values use the defaults.
+type WaitOptions struct {
+	// Interval is the interval between polls. Defaults to DefaultWaitInterval.
+	Interval time.Duration
+	// Successes is the number of consecutive successful results, or suite executions, required to make sure
+	// that the target is stable rather than up by chance. Defaults to 1.
+	Successes int
+	// Since ignores the results and suite executions at or before Since, typically the time of a deployment,
+	// so that those of the previous version don't count. Zero counts every result.
+	Since time.Time
+}
+
+func (o WaitOptions) withDefaults() WaitOptions {
+	if o.Interval <= 0 {
+		o.Interval = DefaultWaitInterval
+	}
+	if o.Successes < 1 {
+		o.Successes = 1
+	}
+	return o
+}
+
+// WaitForHealthy polls the status of the endpoint with the given key until its most recent results are successful,
+// and returns its final status. It is meant to gate deployment pipelines on Gatus confirming that the new version
+// is up.
+//
+// Errors, including the endpoint not existing yet, are retried at the next poll, except for validation errors,
+// which are returned immediately. If ctx is done first, the last status retrieved, if any, is returned along with
+// an error wrapping the error of ctx and the last polling error.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+//	defer cancel()
+//	status, err := client.WaitForHealthy(ctx, "core_api", WaitOptions{
+//	    Interval:  15 * time.Second,
+//	    Successes: 3,
+//	    Since:     deployedAt,
+//	})
+//	if err != nil {
+//	    log.Fatalf("deployment is not healthy: %v", err)
+//	}
+//	fmt.Printf("%s is healthy as of %s\n", status.Name, status.LastResult().Timestamp)
+func (c *Client) WaitForHealthy(ctx context.Context, key string, options WaitOptions, opts ...RequestOption) (*EndpointStatus, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	options = options.withDefaults()
+	var last *EndpointStatus
+	for {
+		status, err := c.GetEndpointStatusByKey(ctx, key, opts...)
+		if err == nil {
+			last = status
+			if consecutiveSuccesses(status.Results, options.Since) >= options.Successes {
+				return status, nil
+			}
+		}
+		if err := c.waitRetry(ctx, err, options.Interval); err != nil {
+			return last, fmt.Errorf("endpoint %s is not healthy: %w", key, err)
+		}
+	}
+}
+
+// WaitForSuiteSuccess polls the status of the suite with the given key until its most recent executions are
+// successful, and returns its final status. It is meant to gate deployment pipelines on the smoke tests of a suite,
+// with WaitOptions.Since set to the time of the deployment so that only executions newer than it count.
+//
+// Errors are handled like WaitForHealthy: they are retried at the next poll, except for validation errors, and
+// if ctx is done first, the last status retrieved, if any, is returned along with an error.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+//	defer cancel()
+//	status, err := client.WaitForSuiteSuccess(ctx, "_check-authentication", WaitOptions{Since: deployedAt})
+//	if err != nil {
+//	    log.Fatalf("smoke tests did not pass: %v", err)
+//	}
+//	fmt.Printf("%s passed in %s\n", status.Name, status.Results[len(status.Results)-1].Elapsed)
+func (c *Client) WaitForSuiteSuccess(ctx context.Context, key string, options WaitOptions, opts ...RequestOption) (*SuiteStatus, error) {
+	if err := ValidateSuiteKey(key); err != nil {
+		return nil, err
+	}
+	options = options.withDefaults()
+	var last *SuiteStatus
+	for {
+		status, err := c.GetSuiteStatusByKey(ctx, key, opts...)
+		if err == nil {
+			last = status
+			if consecutiveSuiteSuccesses(status.Results, options.Since) >= options.Successes {
+				return status, nil
+			}
+		}
+		if err := c.waitRetry(ctx, err, options.Interval); err != nil {
+			return last, fmt.Errorf("suite %s has not succeeded: %w", key, err)
+		}
+	}
+}
+
+// waitRetry waits for the next poll of a Wait function, given the error of the last poll. It returns a non-nil
+// error if waiting should stop, either because err is a validation error or because ctx is done.
+func (c *Client) waitRetry(ctx context.Context, err error, interval time.Duration) error {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return err
+	}
+	if ctx.Err() == nil && c.wait(ctx, interval) {
+		return nil
+	}
+	if errors.Is(err, ctx.Err()) {
+		// The poll was interrupted by ctx, so its error adds nothing
+		return ctx.Err()
+	}
+	return errors.Join(ctx.Err(), err)
+}
+
+// consecutiveSuccesses returns the number of successful results after since at the end of results.
+func consecutiveSuccesses(results []EndpointResult, since time.Time) int {
+	count := 0
+	for i := len(results) - 1; i >= 0; i-- {
+		if !results[i].Success || !results[i].Timestamp.After(since) {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// consecutiveSuiteSuccesses returns the number of successful executions after since at the end of results.
+func consecutiveSuiteSuccesses(results []SuiteResult, since time.Time) int {
+	count := 0
+	for i := len(results) - 1; i >= 0; i-- {
+		if !results[i].Success || !results[i].Timestamp.After(since) {
+			break
+		}
+		count++
+	}
+	return count
+}
@@ -0,0 +1,222 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_WaitForHealthy(t *testing.T) {
+	deployedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := deployedAt.Add(-time.Minute)
+	after := func(minutes int) time.Time { return deployedAt.Add(time.Duration(minutes) * time.Minute) }
+	tests := []struct {
+		name             string
+		options          WaitOptions
+		polls            [][]EndpointResult // nil polls respond with a 404
+		expectedPolls    int
+		expectedErr      bool
+		expectedLastTime time.Time
+	}{
+		{
+			name:    "healthy on first poll",
+			options: WaitOptions{Interval: time.Second},
+			polls: [][]EndpointResult{
+				{{Success: false, Timestamp: before}, {Success: true, Timestamp: after(1)}},
+			},
+			expectedPolls:    1,
+			expectedLastTime: after(1),
+		},
+		{
+			name:    "recovers after failures and missing endpoint",
+			options: WaitOptions{},
+			polls: [][]EndpointResult{
+				nil,
+				{{Success: false, Timestamp: after(1)}},
+				{{Success: false, Timestamp: after(1)}, {Success: true, Timestamp: after(2)}},
+			},
+			expectedPolls:    3,
+			expectedLastTime: after(2),
+		},
+		{
+			name:    "consecutive successes",
+			options: WaitOptions{Successes: 2},
+			polls: [][]EndpointResult{
+				{{Success: false, Timestamp: after(1)}, {Success: true, Timestamp: after(2)}},
+				{{Success: true, Timestamp: after(2)}, {Success: true, Timestamp: after(3)}},
+			},
+			expectedPolls:    2,
+			expectedLastTime: after(3),
+		},
+		{
+			name:    "results before since are ignored",
+			options: WaitOptions{Since: deployedAt},
+			polls: [][]EndpointResult{
+				{{Success: true, Timestamp: before}},
+				{{Success: true, Timestamp: before}, {Success: true, Timestamp: after(1)}},
+			},
+			expectedPolls:    2,
+			expectedLastTime: after(1),
+		},
+		{
+			name:    "context done",
+			options: WaitOptions{Successes: 3},
+			polls: [][]EndpointResult{
+				{{Success: true, Timestamp: after(1)}},
+				{{Success: true, Timestamp: after(1)}, {Success: true, Timestamp: after(2)}},
+			},
+			expectedPolls:    2,
+			expectedErr:      true,
+			expectedLastTime: after(2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var mu sync.Mutex
+			polls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				if polls == len(tt.polls) {
+					// Out of results, give up
+					cancel()
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				results := tt.polls[polls]
+				polls++
+				if results == nil {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				json.NewEncoder(w).Encode(EndpointStatus{Key: "core_api", Results: results})
+			}))
+			defer server.Close()
+
+			clock := &recordingClock{now: deployedAt}
+			client := NewClient(server.URL, WithClock(clock))
+			status, err := client.WaitForHealthy(ctx, "core_api", tt.options)
+			if tt.expectedErr != (err != nil) {
+				t.Fatalf("WaitForHealthy() error = %v, expected error: %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr && !errors.Is(err, context.Canceled) {
+				t.Errorf("expected error to wrap context.Canceled, got %v", err)
+			}
+			if status == nil || status.LastResult() == nil || !status.LastResult().Timestamp.Equal(tt.expectedLastTime) {
+				t.Errorf("expected final status with last result at %s, got %+v", tt.expectedLastTime, status)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if polls != tt.expectedPolls {
+				t.Errorf("expected %d polls, got %d", tt.expectedPolls, polls)
+			}
+			expectedInterval := tt.options.Interval
+			if expectedInterval == 0 {
+				expectedInterval = DefaultWaitInterval
+			}
+			clock.mu.Lock()
+			defer clock.mu.Unlock()
+			for _, d := range clock.timers {
+				if d != expectedInterval {
+					t.Errorf("expected polls %s apart, got %v", expectedInterval, clock.timers)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestClient_WaitForHealthy_InvalidKey(t *testing.T) {
+	client := NewClient("http://localhost")
+	status, err := client.WaitForHealthy(context.Background(), "", WaitOptions{})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("expected *ValidationError, got %v", err)
+	}
+	if status != nil {
+		t.Errorf("expected no status, got %+v", status)
+	}
+}
+
+func TestClient_WaitForSuiteSuccess(t *testing.T) {
+	deployedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	polls := [][]SuiteResult{
+		{{Success: true, Timestamp: deployedAt.Add(-time.Minute)}},
+		{{Success: true, Timestamp: deployedAt.Add(-time.Minute)}, {Success: false, Timestamp: deployedAt.Add(time.Minute)}},
+		{{Success: false, Timestamp: deployedAt.Add(time.Minute)}, {Success: true, Timestamp: deployedAt.Add(2 * time.Minute)}},
+	}
+	var mu sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Path != "/api/v1/suites/_check-authentication/statuses" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		results := polls[min(requests, len(polls)-1)]
+		requests++
+		json.NewEncoder(w).Encode(SuiteStatus{Key: "_check-authentication", Results: results})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, WithClock(&recordingClock{now: deployedAt}))
+	status, err := client.WaitForSuiteSuccess(context.Background(), "_check-authentication", WaitOptions{Since: deployedAt})
+	if err != nil {
+		t.Fatalf("WaitForSuiteSuccess() error = %v", err)
+	}
+	if len(status.Results) != 2 || !status.Results[1].Success {
+		t.Errorf("expected the status with the successful execution, got %+v", status)
+	}
+	if requests != len(polls) {
+		t.Errorf("expected %d polls, got %d", len(polls), requests)
+	}
+
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		client := NewClient(server.URL)
+		status, err := client.WaitForSuiteSuccess(ctx, "_check-authentication", WaitOptions{Interval: 10 * time.Millisecond, Since: deployedAt, Successes: 2})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected error to wrap context.DeadlineExceeded, got %v", err)
+		}
+		if status == nil {
+			t.Error("expected the last status retrieved")
+		}
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		if _, err := client.WaitForSuiteSuccess(context.Background(), "", WaitOptions{}); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestConsecutiveSuccesses(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	results := newTimedResults(start, time.Second, time.Second, time.Second, time.Second)
+	results[1].Success = false
+	tests := []struct {
+		name     string
+		since    time.Time
+		expected int
+	}{
+		{name: "every result", expected: 2},
+		{name: "since", since: start.Add(2 * time.Minute), expected: 1},
+		{name: "since last result", since: start.Add(3 * time.Minute), expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := consecutiveSuccesses(results, tt.since); actual != tt.expected {
+				t.Errorf("got %d, want %d", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package gatussdk
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// SuiteEvent is emitted by WatchSuite when a new suite execution result appears or polling fails.
+type SuiteEvent struct {
+	// Key is the key of the watched suite.
+	Key string
+	// Result is the new suite execution result. It is the zero value if Err is set.
+	Result SuiteResult
+	// Previous is the previous suite execution result, or nil if Result is the first one observed.
+	Previous *SuiteResult
+	// SuccessChanged indicates whether the overall success of the suite flipped compared to Previous.
+	SuccessChanged bool
+	// FailedStep is the name of the first endpoint step that failed in Result, if any.
+	FailedStep string
+	// Flapping indicates whether the suite oscillates between success and failure (see IsFlapping and
+	// WithFlappingDetection). Consumers alerting on SuccessChanged should hold off while it is set,
+	// and alert once on the suite being flapping instead.
+	Flapping bool
+	// Err is the error that occurred while polling, if any. Polling continues after an error.
+	Err error
+}
+
+// WatchSuite polls the status of a suite every interval and emits an event on the returned channel for each
+// new suite execution result, in chronological order. The first event contains the most recent result at the
+// time WatchSuite is called. Polling errors are emitted as events with Err set. If interval is zero or negative,
+// DefaultPollInterval is used.
+//
+// The channel is closed once ctx is done. Events are not dropped, so polling pauses while events are not consumed.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+//	defer cancel()
+//	for event := range client.WatchSuite(ctx, "_check-authentication", 30*time.Second) {
+//	    if event.Err != nil {
+//	        log.Printf("polling failed: %v", event.Err)
+//	        continue
+//	    }
+//	    if !event.Result.Success {
+//	        log.Printf("suite failed at step %s", event.FailedStep)
+//	    }
+//	}
+func (c *Client) WatchSuite(ctx context.Context, key string, interval time.Duration, opts ...RequestOption) <-chan SuiteEvent {
+	events := make(chan SuiteEvent)
+	go func() {
+		defer close(events)
+		var last *SuiteResult
+		schedule := c.newPollSchedule(interval)
+		for {
+			changed := false
+			for _, event := range c.pollSuite(ctx, key, &last, opts) {
+				changed = changed || event.SuccessChanged || event.Err != nil
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !c.wait(ctx, schedule.next(last != nil && !last.Success, changed)) {
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// pollSuite retrieves the status of a suite and returns an event for each result more recent than last,
+// updating last to the most recent result.
+func (c *Client) pollSuite(ctx context.Context, key string, last **SuiteResult, opts []RequestOption) []SuiteEvent {
+	status, err := c.GetSuiteStatusByKey(ctx, key, opts...)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return []SuiteEvent{{Key: key, Err: err}}
+	}
+	results := status.Results
+	if *last == nil && len(results) > 0 {
+		// Only report the most recent result when starting to watch
+		results = results[len(results)-1:]
+	}
+	var events []SuiteEvent
+	offset := len(status.Results) - len(results)
+	for i := range results {
+		result := results[i]
+		previous := *last
+		if previous != nil && !result.Timestamp.After(previous.Timestamp) {
+			continue
+		}
+		events = append(events, SuiteEvent{
+			Key:            key,
+			Result:         result,
+			Previous:       previous,
+			SuccessChanged: previous != nil && previous.Success != result.Success,
+			FailedStep:     failedStep(result),
+			Flapping:       isSuiteFlapping(status.Results[:offset+i+1], c.flapping),
+		})
+		*last = &result
+	}
+	return events
+}
+
+// failedStep returns the name of the first endpoint step that failed in result, or an empty string if none failed.
+func failedStep(result SuiteResult) string {
+	for _, endpointResult := range result.EndpointResults {
+		if !endpointResult.Success {
+			return endpointResult.Name
+		}
+	}
+	return ""
+}
+
+// EndpointEvent is emitted by WatchEndpoint and Poller.WatchEndpoint when a new result of an endpoint appears
+// or polling fails.
+type EndpointEvent struct {
+	// Key is the key of the watched endpoint.
+	Key string
+	// Result is the new result. It is the zero value if Err is set.
+	Result EndpointResult
+	// Previous is the previous result, or nil if Result is the first one observed.
+	Previous *EndpointResult
+	// SuccessChanged indicates whether the success of the endpoint flipped compared to Previous.
+	SuccessChanged bool
+	// Flapping indicates whether the endpoint oscillates between success and failure (see IsFlapping and
+	// WithFlappingDetection).
+	Flapping bool
+	// Err is the error that occurred while polling, if any. Polling continues after an error.
+	Err error
+}
+
+// WatchEndpoint polls the status of an endpoint every interval and emits an event on the returned channel for
+// each new result, in chronological order. The first event contains the most recent result at the time
+// WatchEndpoint is called. Polling errors are emitted as events with Err set. If interval is zero or negative,
+// DefaultPollInterval is used.
+//
+// The channel is closed once ctx is done. Events are not dropped, so polling pauses while events are not consumed.
+// To watch many endpoints, use a Poller, which shares a single request per interval between all watchers.
+//
+// Example:
+//
+//	for event := range client.WatchEndpoint(ctx, "core_api", 30*time.Second) {
+//	    if event.Err == nil && event.SuccessChanged {
+//	        log.Printf("core_api is now %s", event.Result.Severity())
+//	    }
+//	}
+func (c *Client) WatchEndpoint(ctx context.Context, key string, interval time.Duration, opts ...RequestOption) <-chan EndpointEvent {
+	events := make(chan EndpointEvent)
+	go func() {
+		defer close(events)
+		var last *EndpointResult
+		schedule := c.newPollSchedule(interval)
+		for {
+			var polled []EndpointEvent
+			status, err := c.GetEndpointStatusByKey(ctx, key, opts...)
+			if err == nil {
+				polled = endpointEvents(key, status.Results, &last, c.flapping)
+			} else if ctx.Err() == nil {
+				polled = []EndpointEvent{{Key: key, Err: err}}
+			}
+			changed := false
+			for _, event := range polled {
+				changed = changed || event.SuccessChanged || event.Err != nil
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !c.wait(ctx, schedule.next(last != nil && !last.Success, changed)) {
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// endpointEvents returns an event for each of the results more recent than last, updating last to the most
+// recent result.
+func endpointEvents(key string, results []EndpointResult, last **EndpointResult, flapping FlappingOptions) []EndpointEvent {
+	offset := 0
+	if *last == nil && len(results) > 0 {
+		// Only report the most recent result when starting to watch
+		offset = len(results) - 1
+	}
+	var events []EndpointEvent
+	for i := offset; i < len(results); i++ {
+		result := results[i]
+		previous := *last
+		if previous != nil && !result.Timestamp.After(previous.Timestamp) {
+			continue
+		}
+		events = append(events, EndpointEvent{
+			Key:            key,
+			Result:         result,
+			Previous:       previous,
+			SuccessChanged: previous != nil && previous.Success != result.Success,
+			Flapping:       IsFlapping(results[:i+1], flapping),
+		})
+		*last = &result
+	}
+	return events
+}
+
+// GroupState is the aggregate health of the endpoints of a group.
+type GroupState int
+
+const (
+	// GroupStateUnknown means no endpoint of the group has a result to assess.
+	GroupStateUnknown GroupState = iota
+	// GroupStateUp means the most recent result of every endpoint of the group succeeded.
+	GroupStateUp
+	// GroupStatePartial means the most recent result of some, but not all, endpoints of the group failed.
+	GroupStatePartial
+	// GroupStateDown means the most recent result of every endpoint of the group failed.
+	GroupStateDown
+)
+
+// String returns the name of the state.
+func (s GroupState) String() string {
+	switch s {
+	case GroupStateUp:
+		return "up"
+	case GroupStatePartial:
+		return "partial"
+	case GroupStateDown:
+		return "down"
+	}
+	return "unknown"
+}
+
+// MarshalText encodes the state as its name.
+func (s GroupState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// GroupEvent is emitted by WatchGroup when the aggregate health of a group changes or polling fails.
+type GroupEvent struct {
+	// Group is the watched group.
+	Group string
+	// State is the new aggregate health of the group. It is GroupStateUnknown if Err is set.
+	State GroupState
+	// Previous is the previous aggregate health of the group, or GroupStateUnknown for the first event.
+	Previous GroupState
+	// Total is the number of endpoints in the group.
+	Total int
+	// Failing contains the keys of the endpoints of the group whose most recent result failed, sorted.
+	Failing []string
+	// Err is the error that occurred while polling, if any. Polling continues after an error.
+	Err error
+}
+
+// WatchGroup polls the status of every endpoint every interval and emits an event on the returned channel
+// whenever the aggregate health of the endpoints of group changes, for instance from all up to some down,
+// or from some down to all down. Changes of individual endpoints that don't affect the aggregate health,
+// such as another endpoint failing while the group is already partially down, are not reported, which keeps
+// on-call summaries concise. The first event contains the health of the group at the time WatchGroup is called.
+// Polling errors are emitted as events with Err set. If interval is zero or negative, DefaultPollInterval is used.
+//
+// The channel is closed once ctx is done. Events are not dropped, so polling pauses while events are not consumed.
+//
+// Example:
+//
+//	for event := range client.WatchGroup(ctx, "core", time.Minute) {
+//	    if event.Err != nil {
+//	        log.Printf("polling failed: %v", event.Err)
+//	        continue
+//	    }
+//	    log.Printf("core is %s (%d/%d failing: %s)", event.State, len(event.Failing), event.Total, strings.Join(event.Failing, ", "))
+//	}
+func (c *Client) WatchGroup(ctx context.Context, group string, interval time.Duration, opts ...RequestOption) <-chan GroupEvent {
+	events := make(chan GroupEvent)
+	go func() {
+		defer close(events)
+		var last *GroupEvent
+		schedule := c.newPollSchedule(interval)
+		for {
+			event, changed := c.pollGroup(ctx, group, &last, opts)
+			if changed {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			down := last != nil && (last.State == GroupStatePartial || last.State == GroupStateDown)
+			if !c.wait(ctx, schedule.next(down, changed)) {
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// pollGroup retrieves the status of every endpoint and returns an event if the aggregate health of group
+// differs from last, updating last.
+func (c *Client) pollGroup(ctx context.Context, group string, last **GroupEvent, opts []RequestOption) (GroupEvent, bool) {
+	statuses, err := c.GetAllEndpointStatuses(ctx, opts...)
+	if err != nil {
+		if ctx.Err() != nil {
+			return GroupEvent{}, false
+		}
+		return GroupEvent{Group: group, Err: err}, true
+	}
+	event := groupEvent(group, statuses)
+	if *last != nil {
+		if (*last).State == event.State {
+			return GroupEvent{}, false
+		}
+		event.Previous = (*last).State
+	}
+	*last = &event
+	return event, true
+}
+
+// groupEvent returns an event describing the aggregate health of the endpoints of group among statuses.
+func groupEvent(group string, statuses []EndpointStatus) GroupEvent {
+	event := GroupEvent{Group: group}
+	var health GroupHealth
+	for i := range statuses {
+		if statuses[i].Group != group {
+			continue
+		}
+		health.Total++
+		last := statuses[i].LastResult()
+		switch {
+		case last == nil:
+			health.Unknown++
+		case last.Success:
+			health.Up++
+		default:
+			health.Down++
+			event.Failing = append(event.Failing, statuses[i].Key)
+		}
+	}
+	slices.Sort(event.Failing)
+	event.Total = health.Total
+	event.State = health.State()
+	return event
+}
@@ -0,0 +1,177 @@
+package gatussdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_WatchSuite(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	results := []SuiteResult{
+		{Success: true, Timestamp: now.Add(-2 * time.Minute)},
+		{Success: true, Timestamp: now.Add(-time.Minute)},
+	}
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(SuiteStatus{Key: "_check-authentication", Results: results})
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := NewClient(server.URL).WatchSuite(ctx, "_check-authentication", 10*time.Millisecond)
+
+	first := <-events
+	if first.Err != nil || !first.Result.Timestamp.Equal(now.Add(-time.Minute)) || first.Previous != nil {
+		t.Fatalf("unexpected first event: %+v", first)
+	}
+
+	mu.Lock()
+	results = append(results,
+		SuiteResult{Success: false, Timestamp: now, EndpointResults: []EndpointResult{
+			{Name: "login", Success: true},
+			{Name: "get-profile", Success: false},
+		}},
+		SuiteResult{Success: false, Timestamp: now.Add(time.Minute)},
+	)
+	mu.Unlock()
+
+	flipped := <-events
+	if flipped.Err != nil || !flipped.SuccessChanged || flipped.FailedStep != "get-profile" {
+		t.Errorf("unexpected event: %+v", flipped)
+	}
+	if flipped.Previous == nil || !flipped.Previous.Timestamp.Equal(now.Add(-time.Minute)) {
+		t.Errorf("unexpected previous result: %+v", flipped.Previous)
+	}
+	unchanged := <-events
+	if unchanged.SuccessChanged || !unchanged.Result.Timestamp.Equal(now.Add(time.Minute)) {
+		t.Errorf("unexpected event: %+v", unchanged)
+	}
+
+	mu.Lock()
+	failing = true
+	mu.Unlock()
+	if event := <-events; event.Err == nil {
+		t.Errorf("expected polling error, got %+v", event)
+	}
+
+	cancel()
+	for range events {
+		// Drain until the channel is closed
+	}
+}
+
+func TestClient_WatchSuite_Flapping(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	results := []SuiteResult{
+		{Success: true, Timestamp: now.Add(-4 * time.Minute)},
+		{Success: false, Timestamp: now.Add(-3 * time.Minute)},
+		{Success: true, Timestamp: now.Add(-2 * time.Minute)},
+		{Success: false, Timestamp: now.Add(-time.Minute)},
+	}
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(SuiteStatus{Key: "_check-authentication", Results: results})
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := NewClient(server.URL, WithFlappingDetection(FlappingOptions{MaxTransitions: 3}))
+	events := client.WatchSuite(ctx, "_check-authentication", 10*time.Millisecond)
+	if first := <-events; first.Flapping {
+		t.Errorf("expected 3 transitions not to be flapping: %+v", first)
+	}
+	mu.Lock()
+	results = append(results, SuiteResult{Success: true, Timestamp: now})
+	mu.Unlock()
+	if event := <-events; !event.Flapping || !event.SuccessChanged {
+		t.Errorf("expected 4 transitions to be flapping: %+v", event)
+	}
+}
+
+func TestClient_WatchGroup(t *testing.T) {
+	var mu sync.Mutex
+	statuses := []EndpointStatus{
+		{Key: "core_api", Group: "core", Results: []EndpointResult{{Success: true}}},
+		{Key: "core_db", Group: "core", Results: []EndpointResult{{Success: true}}},
+		{Key: "core_new", Group: "core"},
+		{Key: "other_api", Group: "other", Results: []EndpointResult{{Success: false}}},
+	}
+	setSuccess := func(successes map[int]bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		for index, success := range successes {
+			statuses[index].Results = append(statuses[index].Results, EndpointResult{Success: success})
+		}
+	}
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(statuses)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	events := NewClient(server.URL).WatchGroup(ctx, "core", 10*time.Millisecond)
+
+	first := <-events
+	if first.Err != nil || first.State != GroupStateUp || first.Previous != GroupStateUnknown || first.Total != 3 || len(first.Failing) != 0 {
+		t.Fatalf("unexpected first event: %+v", first)
+	}
+
+	setSuccess(map[int]bool{1: false})
+	partial := <-events
+	if partial.State != GroupStatePartial || partial.Previous != GroupStateUp || len(partial.Failing) != 1 || partial.Failing[0] != "core_db" {
+		t.Errorf("unexpected event: %+v", partial)
+	}
+
+	// core_db recovering while core_api fails doesn't change the aggregate health, so no event is emitted
+	setSuccess(map[int]bool{0: false, 1: true})
+	time.Sleep(30 * time.Millisecond)
+	setSuccess(map[int]bool{1: false})
+	down := <-events
+	if down.State != GroupStateDown || down.Previous != GroupStatePartial || len(down.Failing) != 2 {
+		t.Errorf("unexpected event: %+v", down)
+	}
+
+	mu.Lock()
+	failing = true
+	mu.Unlock()
+	if event := <-events; event.Err == nil {
+		t.Errorf("expected polling error, got %+v", event)
+	}
+}
+
+func TestGroupState_String(t *testing.T) {
+	for state, expected := range map[GroupState]string{
+		GroupStateUnknown: "unknown",
+		GroupStateUp:      "up",
+		GroupStatePartial: "partial",
+		GroupStateDown:    "down",
+	} {
+		if text, _ := state.MarshalText(); state.String() != expected || string(text) != expected {
+			t.Errorf("String() = %q, want %q", state.String(), expected)
+		}
+	}
+}